@@ -18,6 +18,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/CRTYPUBG/winux/internal/config"
+	"github.com/CRTYPUBG/winux/internal/output"
 	"github.com/CRTYPUBG/winux/internal/updater"
 )
 
@@ -57,7 +59,12 @@ func main() {
 
 	switch os.Args[1] {
 	case "--check", "-c":
-		checkForUpdates()
+		format, err := outputFormat(os.Args[2:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		checkForUpdates(format)
 	case "--apply", "-a":
 		applyUpdate(false)
 	case "--force", "-f":
@@ -82,21 +89,33 @@ Usage: update.exe [option]
 
 Options:
   --check, -c     Check for available updates
+                  (--output=json or ndjson for scripts)
   --apply, -a     Download and apply update if available
   --force, -f     Force reinstall even if up-to-date
   --startup, -s   Delayed startup check with GUI notification
+                  (see updater.policy in 'winux config')
   --version, -v   Show version
   --help, -h      Show this help
 
 Examples:
   update.exe --check
+  update.exe --check --output=json
   update.exe --apply
   update.exe --startup`)
 }
 
 // startupCheck performs a delayed update check with GUI notification
-// This is intended to be called at system startup or app launch
+// This is intended to be called at system startup or app launch.
+// The updater.policy setting can turn it off or install without asking.
 func startupCheck() {
+	conf, err := config.Load(config.Path(os.Getenv))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: config: %v\n", err)
+	}
+	if conf.UpdatePolicy == "off" {
+		return
+	}
+
 	// Wait before checking (let system settle)
 	time.Sleep(StartupDelay)
 
@@ -107,6 +126,11 @@ func startupCheck() {
 		return
 	}
 
+	if conf.UpdatePolicy == "auto" {
+		applyUpdate(false)
+		return
+	}
+
 	// Show Windows notification dialog
 	result := updater.ShowUpdateNotification(info)
 
@@ -120,9 +144,44 @@ func startupCheck() {
 	}
 }
 
-func checkForUpdates() {
-	fmt.Println("Checking for updates...")
-	
+// checkResult is the result of --check with --output=json or ndjson.
+type checkResult struct {
+	Current   string   `json:"current"`
+	Latest    string   `json:"latest"`
+	Available bool     `json:"available"`
+	URL       string   `json:"url"`
+	Summary   []string `json:"summary"`
+}
+
+// outputFormat returns the format chosen by the options after --check:
+// --output=FORMAT, --output FORMAT or --json.
+func outputFormat(args []string) (output.Format, error) {
+	format := output.Text
+	for i := 0; i < len(args); i++ {
+		var err error
+		switch a := args[i]; {
+		case a == "--json":
+			format = output.JSON
+		case strings.HasPrefix(a, "--output="):
+			format, err = output.Parse(strings.TrimPrefix(a, "--output="))
+		case a == "--output" && i+1 < len(args):
+			i++
+			format, err = output.Parse(args[i])
+		default:
+			err = fmt.Errorf("unexpected argument: %s", a)
+		}
+		if err != nil {
+			return output.Text, err
+		}
+	}
+	return format, nil
+}
+
+func checkForUpdates(format output.Format) {
+	if !format.Structured() {
+		fmt.Println("Checking for updates...")
+	}
+
 	release, err := getLatestRelease()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -130,14 +189,33 @@ func checkForUpdates() {
 	}
 
 	latestVersion := strings.TrimPrefix(release.TagName, "v")
-	
-	if compareVersions(latestVersion, CurrentVersion) > 0 {
+	available := compareVersions(latestVersion, CurrentVersion) > 0
+	summary := updater.ParseChangelogSummary(release.Body, 5)
+
+	if format.Structured() {
+		result := checkResult{
+			Current:   CurrentVersion,
+			Latest:    latestVersion,
+			Available: available,
+			URL:       "https://github.com/CRTYPUBG/winux/releases/tag/" + release.TagName,
+			Summary:   []string{},
+		}
+		if available && summary != nil {
+			result.Summary = summary
+		}
+		if err := output.Write(os.Stdout, format, result); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if available {
 		fmt.Printf("\n✅ Update available!\n")
 		fmt.Printf("   Current: v%s\n", CurrentVersion)
 		fmt.Printf("   Latest:  %s\n", release.TagName)
 		
 		// Show changelog summary (first 5 lines)
-		summary := updater.CheckForUpdates().Summary
 		if len(summary) > 0 {
 			fmt.Println("\n━━━━━━━━━━━━━━━━━━━━━━━━━━")
 			fmt.Println("📋 Neler Yeni:")
@@ -33,19 +33,50 @@ func init() {
 
 	// Register all commands
 	// v0.1.0
-	core.Register("ls", commands.Ls)
-	core.Register("cat", commands.Cat)
-	core.Register("grep", commands.Grep)
+	core.Register("ls", commands.Ls, core.Since("0.1.0"),
+		core.Summary("List directory contents"), core.Flags(commands.LsFlags),
+		core.Structured())
+	core.Register("cat", commands.Cat, core.Since("0.1.0"),
+		core.Summary("Concatenate and print files"), core.Flags(commands.CatFlags))
+	core.Register("grep", commands.Grep, core.Since("0.1.0"),
+		core.Summary("Search for patterns in files"), core.Flags(commands.GrepFlags),
+		core.Structured())
 	// v0.2.0
-	core.Register("rm", commands.Rm)
-	core.Register("mkdir", commands.Mkdir)
-	core.Register("touch", commands.Touch)
-	core.Register("pwd", commands.Pwd)
-	core.Register("echo", commands.Echo)
+	core.Register("rm", commands.Rm, core.Since("0.2.0"),
+		core.Summary("Remove files or directories"), core.Flags(commands.RmFlags))
+	core.Register("mkdir", commands.Mkdir, core.Since("0.2.0"),
+		core.Summary("Create directories"), core.Flags(commands.MkdirFlags))
+	core.Register("touch", commands.Touch, core.Since("0.2.0"),
+		core.Summary("Create files or update timestamps"), core.Flags(commands.TouchFlags))
+	core.Register("pwd", commands.Pwd, core.Since("0.2.0"),
+		core.Summary("Print working directory"), core.Flags(commands.PwdFlags),
+		core.Structured())
+	core.Register("echo", commands.Echo, core.Since("0.2.0"),
+		core.Summary("Display a line of text"), core.Flags(commands.EchoFlags))
 	// v0.3.0
-	core.Register("whoami", commands.Whoami)
-	core.Register("uptime", commands.Uptime)
-	core.Register("nano", commands.Nano)
+	core.Register("whoami", commands.Whoami, core.Since("0.3.0"),
+		core.Summary("Print effective username"), core.Flags(commands.WhoamiFlags),
+		core.Structured())
+	core.Register("uptime", commands.Uptime, core.Since("0.3.0"),
+		core.Summary("Display system uptime"), core.Flags(commands.UptimeFlags),
+		core.Structured())
+	core.Register("nano", commands.Nano, core.Since("0.3.0"),
+		core.Summary("Edit text files"), core.Flags(commands.NanoFlags))
+	// v0.4.0
+	core.Register("pipe", core.Pipe, core.NoGlob(), core.Since("0.4.0"),
+		core.Summary("Run a pipeline of commands in one process"), core.Flags(core.PipeFlags))
+	core.Register("sh", commands.Sh, core.NoGlob(), core.Since("0.4.0"),
+		core.Summary("Run shell scripts and commands"), core.Flags(commands.ShFlags))
+	core.Register("help", core.Help, core.Since("0.4.0"),
+		core.Summary("Show help and manual pages for commands"), core.Flags(core.HelpFlags),
+		core.Completer(core.CompleteCommands), core.NoLink())
+	core.Register("completion", core.Completion, core.Since("0.4.0"),
+		core.Summary("Print shell completion scripts"), core.Flags(core.CompletionFlags),
+		core.Completer(core.CompleteShells), core.NoLink())
+	core.Register("config", core.Config, core.Since("0.4.0"),
+		core.Summary("Get and set winux options"), core.Flags(core.ConfigFlags),
+		core.Completer(core.CompleteConfig), core.NoLink())
+	core.Register("__complete", core.Complete, core.Hidden(), core.NoGlob())
 }
 
 func main() {
@@ -0,0 +1,174 @@
+// Package ahocorasick finds occurrences of many strings in a text in
+// one pass, using the Aho-Corasick automaton. The time to search is
+// linear in the length of the text and the number of matches, however
+// many strings there are, which makes it suited to scanning logs for
+// long lists of fixed strings.
+package ahocorasick
+
+import "sort"
+
+// Matcher searches for a fixed set of strings. It is safe for
+// concurrent use.
+type Matcher struct {
+	nodes    []node
+	root     [256]int32 // transitions of the root, which are all defined
+	lens     []int      // of the patterns, by index
+	fold     bool
+	matchAll bool // an empty pattern matches everywhere
+}
+
+type node struct {
+	bytes []byte  // labels of the edges to children, sorted
+	next  []int32 // children, parallel to bytes
+	fail  int32   // the longest proper suffix that is a prefix of a pattern
+	out   int32   // pattern ending here, or -1
+	dict  int32   // nearest node on the fail chain with out >= 0, or -1
+}
+
+// Match is an occurrence of a pattern in a text.
+type Match struct {
+	Pattern    int // index in the patterns given to New
+	Start, End int // byte offsets in the text
+}
+
+// New returns a Matcher for patterns. With ignoreCase, ASCII letters
+// match regardless of case; other characters must match exactly.
+// Duplicate patterns are reported under the first index.
+func New(patterns []string, ignoreCase bool) *Matcher {
+	m := &Matcher{fold: ignoreCase, lens: make([]int, len(patterns))}
+	m.nodes = append(m.nodes, node{out: -1, dict: -1})
+	for i, p := range patterns {
+		m.lens[i] = len(p)
+		if p == "" {
+			m.matchAll = true
+			continue
+		}
+		n := int32(0)
+		for j := 0; j < len(p); j++ {
+			n = m.child(n, m.lower(p[j]))
+		}
+		if m.nodes[n].out < 0 {
+			m.nodes[n].out = int32(i)
+		}
+	}
+	m.link()
+	return m
+}
+
+func (m *Matcher) lower(c byte) byte {
+	if m.fold && 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// child returns the child of n labelled c, adding it if needed.
+func (m *Matcher) child(n int32, c byte) int32 {
+	nd := &m.nodes[n]
+	i := sort.Search(len(nd.bytes), func(i int) bool { return nd.bytes[i] >= c })
+	if i < len(nd.bytes) && nd.bytes[i] == c {
+		return nd.next[i]
+	}
+	id := int32(len(m.nodes))
+	nd.bytes = append(nd.bytes, 0)
+	nd.next = append(nd.next, 0)
+	copy(nd.bytes[i+1:], nd.bytes[i:])
+	copy(nd.next[i+1:], nd.next[i:])
+	nd.bytes[i], nd.next[i] = c, id
+	m.nodes = append(m.nodes, node{out: -1, dict: -1})
+	return id
+}
+
+// edge returns the child of n labelled c, or -1.
+func (m *Matcher) edge(n int32, c byte) int32 {
+	if n == 0 {
+		return m.root[c]
+	}
+	nd := &m.nodes[n]
+	i := sort.Search(len(nd.bytes), func(i int) bool { return nd.bytes[i] >= c })
+	if i < len(nd.bytes) && nd.bytes[i] == c {
+		return nd.next[i]
+	}
+	return -1
+}
+
+// link computes the fail and dictionary links breadth first, and
+// completes the transitions of the root.
+func (m *Matcher) link() {
+	root := &m.nodes[0]
+	queue := make([]int32, 0, len(m.nodes))
+	for i, c := range root.bytes {
+		m.root[c] = root.next[i]
+		queue = append(queue, root.next[i])
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		nd := &m.nodes[n]
+		for i, c := range nd.bytes {
+			child := nd.next[i]
+			f := nd.fail
+			for f != 0 && m.edge(f, c) < 0 {
+				f = m.nodes[f].fail
+			}
+			fc := m.root[c]
+			if f != 0 {
+				fc = m.edge(f, c)
+			}
+			if fc == child {
+				fc = 0
+			}
+			m.nodes[child].fail = fc
+			if m.nodes[fc].out >= 0 {
+				m.nodes[child].dict = fc
+			} else {
+				m.nodes[child].dict = m.nodes[fc].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// step returns the state after reading c in state n.
+func (m *Matcher) step(n int32, c byte) int32 {
+	c = m.lower(c)
+	for n != 0 {
+		if next := m.edge(n, c); next >= 0 {
+			return next
+		}
+		n = m.nodes[n].fail
+	}
+	return m.root[c]
+}
+
+// Contains reports whether any pattern occurs in s.
+func (m *Matcher) Contains(s string) bool {
+	if m.matchAll {
+		return true
+	}
+	n := int32(0)
+	for i := 0; i < len(s); i++ {
+		n = m.step(n, s[i])
+		if m.nodes[n].out >= 0 || m.nodes[n].dict >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// FindAll returns every occurrence of every pattern in s, including
+// overlapping ones, ordered by end and then by decreasing length.
+// Empty patterns are not reported.
+func (m *Matcher) FindAll(s string) []Match {
+	var matches []Match
+	n := int32(0)
+	for i := 0; i < len(s); i++ {
+		n = m.step(n, s[i])
+		for d := n; d >= 0; d = m.nodes[d].dict {
+			if p := m.nodes[d].out; p >= 0 {
+				matches = append(matches, Match{Pattern: int(p), Start: i + 1 - m.lens[p], End: i + 1})
+			}
+		}
+	}
+	return matches
+}
@@ -0,0 +1,136 @@
+package ahocorasick
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// naive finds the matches FindAll should return with strings.Index,
+// in the same order: by end, and longest first among those ending
+// together.
+func naive(patterns []string, s string, ignoreCase bool) []Match {
+	first := make(map[string]int)
+	var matches []Match
+	for i, p := range patterns {
+		if ignoreCase {
+			p = lowerASCII(p)
+		}
+		if _, dup := first[p]; dup || p == "" {
+			continue
+		}
+		first[p] = i
+		text := s
+		if ignoreCase {
+			text = lowerASCII(s)
+		}
+		for start := 0; ; start++ {
+			j := strings.Index(text[start:], p)
+			if j < 0 {
+				break
+			}
+			start += j
+			matches = append(matches, Match{Pattern: i, Start: start, End: start + len(p)})
+		}
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].End != matches[b].End {
+			return matches[a].End < matches[b].End
+		}
+		return matches[a].Start < matches[b].Start
+	})
+	return matches
+}
+
+// lowerASCII lowers ASCII letters only, as New does with ignoreCase.
+func lowerASCII(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		patterns   []string
+		text       string
+		ignoreCase bool
+		want       []Match
+	}{
+		{"none", []string{"x"}, "abc", false, nil},
+		{"one", []string{"b"}, "abcb", false, []Match{{0, 1, 2}, {0, 3, 4}}},
+		{"overlapping", []string{"aa"}, "aaaa", false, []Match{{0, 0, 2}, {0, 1, 3}, {0, 2, 4}}},
+		{"classic", []string{"he", "she", "his", "hers"}, "ushers", false, []Match{
+			{1, 1, 4}, {0, 2, 4}, {3, 2, 6},
+		}},
+		{"prefix", []string{"ab", "abcd"}, "abcd", false, []Match{{0, 0, 2}, {1, 0, 4}}},
+		{"suffix", []string{"abcd", "cd", "d"}, "abcd", false, []Match{{0, 0, 4}, {1, 2, 4}, {2, 3, 4}}},
+		{"duplicates", []string{"a", "b", "a"}, "ab", false, []Match{{0, 0, 1}, {1, 1, 2}}},
+		{"empty pattern", []string{"", "b"}, "ab", false, []Match{{1, 1, 2}}},
+		{"case", []string{"Err"}, "err ERR Err", false, []Match{{0, 8, 11}}},
+		{"ignore case", []string{"Err"}, "err ERR Err", true, []Match{{0, 0, 3}, {0, 4, 7}, {0, 8, 11}}},
+		{"ignore case ASCII only", []string{"é"}, "É é", true, []Match{{0, 3, 5}}},
+		{"bytes", []string{"\x00\xff"}, "a\x00\xff\x00", false, []Match{{0, 1, 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(tt.patterns, tt.ignoreCase)
+			if got := m.FindAll(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+			if got, want := m.Contains(tt.text), len(tt.want) > 0 || contains(tt.patterns, ""); got != want {
+				t.Errorf("Contains(%q) = %v, want %v", tt.text, got, want)
+			}
+		})
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TestFindAllRandom checks FindAll against strings.Index on texts and
+// patterns over a small alphabet, where overlaps and shared prefixes
+// and suffixes are common.
+func TestFindAllRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	word := func(alphabet string, max int) string {
+		b := make([]byte, 1+rng.Intn(max))
+		for i := range b {
+			b[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+	for i := 0; i < 2000; i++ {
+		ignoreCase := i%2 == 1
+		alphabet := "abc"
+		if ignoreCase {
+			alphabet = "abAB"
+		}
+		patterns := make([]string, 1+rng.Intn(8))
+		for j := range patterns {
+			patterns[j] = word(alphabet, 5)
+		}
+		text := word(alphabet+"x", 40)
+
+		m := New(patterns, ignoreCase)
+		want := naive(patterns, text, ignoreCase)
+		if got := m.FindAll(text); !reflect.DeepEqual(got, want) {
+			t.Fatalf("New(%q, %v).FindAll(%q) = %v, want %v", patterns, ignoreCase, text, got, want)
+		}
+		if m.Contains(text) != (len(want) > 0) {
+			t.Fatalf("New(%q, %v).Contains(%q) = %v", patterns, ignoreCase, text, !(len(want) > 0))
+		}
+	}
+}
@@ -4,78 +4,116 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"os"
-	"strings"
+	"strconv"
 
-	winuxio "github.com/CRTYPUBG/winux/internal/io"
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
+// catOptions holds the parsed cat flags.
+type catOptions struct {
+	numberLines     bool // -n: number all output lines
+	numberNonBlank  bool // -b: number non-blank output lines
+	showEnds        bool // -E: print $ at the end of each line
+	showTabs        bool // -T: print TAB as ^I
+	showNonprinting bool // -v: use ^ and M- notation, except for LFD and TAB
+	squeezeBlank    bool // -s: print one empty line for several
+	showCRLF        bool // --crlf: print ^M before Windows line endings
+}
+
+// formatted reports whether output differs from input.
+func (o *catOptions) formatted() bool {
+	return o.numberLines || o.numberNonBlank || o.showEnds || o.showTabs ||
+		o.showNonprinting || o.squeezeBlank || o.showCRLF
+}
+
+func newCatFlags(o *catOptions) *flags.FlagSet {
+	fs := flags.New("cat", "[OPTION]... [FILE]...")
+	fs.Description = `Concatenate FILE(s) to standard output.
+
+With no FILE, or when FILE is -, read standard input.`
+	fs.Func("A,show-all", "", "equivalent to -vET", func(string) error {
+		o.showNonprinting, o.showEnds, o.showTabs = true, true, true
+		return nil
+	})
+	fs.Bool(&o.numberNonBlank, "b,number-nonblank", "number nonempty output lines")
+	fs.Func("e", "", "equivalent to -vE", func(string) error {
+		o.showNonprinting, o.showEnds = true, true
+		return nil
+	})
+	fs.Bool(&o.showEnds, "E,show-ends", "display $ at end of each line")
+	fs.Bool(&o.numberLines, "n,number", "number all output lines")
+	fs.Bool(&o.squeezeBlank, "s,squeeze-blank", "suppress repeated empty output lines")
+	fs.Func("t", "", "equivalent to -vT", func(string) error {
+		o.showNonprinting, o.showTabs = true, true
+		return nil
+	})
+	fs.Bool(&o.showTabs, "T,show-tabs", "display TAB characters as ^I")
+	fs.Bool(&o.showNonprinting, "v,show-nonprinting", "use ^ and M- notation, except for LFD and TAB")
+	fs.Bool(&o.showCRLF, "crlf", "display ^M before Windows (CRLF) line endings,\nleaving other characters as they are")
+	fs.Footer = `With -E, -v or --crlf, the carriage return of a CRLF line ending
+is displayed as ^M. A line with nothing before its CRLF ending counts
+as empty for -b and -s, unlike in GNU cat. Lines may be of any length.`
+	fs.Examples = []string{
+		"cat file.txt",
+		"cat -n file.txt",
+		"type file.txt | winux cat -n",
+		"cat -A script.ps1",
+		"cat --crlf -E mixed.txt",
+	}
+	return fs
+}
+
+// CatFlags returns the cat flag set, for help and documentation.
+func CatFlags() *flags.FlagSet {
+	return newCatFlags(&catOptions{})
+}
+
 // Cat implements the cat command.
-// Usage: cat [-n] [-b] [file...]
-func Cat(args []string) int {
-	// Parse flags
-	numberLines := false    // -n: number all output lines
-	numberNonBlank := false // -b: number non-blank output lines
-
-	var files []string
-
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'n':
-					numberLines = true
-				case 'b':
-					numberNonBlank = true
-				default:
-					fmt.Fprintf(os.Stderr, "cat: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
-			}
-		} else if arg == "--number" {
-			numberLines = true
-		} else if arg == "--number-nonblank" {
-			numberNonBlank = true
-		} else if arg == "--help" {
-			printCatHelp()
-			return utils.ExitSuccess
-		} else if arg == "-" {
-			files = append(files, "-") // stdin marker
-		} else {
-			files = append(files, arg)
-		}
+// Usage: cat [-AbeEnstTv] [--crlf] [file...]
+func Cat(ctx *core.Context, args []string) int {
+	var o catOptions
+	fs := newCatFlags(&o)
+	files, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	// -b overrides -n
-	if numberNonBlank {
-		numberLines = false
+	if o.numberNonBlank {
+		o.numberLines = false
 	}
 
 	// If no files specified, read from stdin
 	if len(files) == 0 {
-		if winuxio.IsPiped() {
+		if ctx.StdinPiped() {
 			files = []string{"-"}
 		} else {
 			// No input at all
-			printCatHelp()
+			fs.PrintHelp(ctx.Stdout)
 			return utils.ExitUsageError
 		}
 	}
 
 	exitCode := utils.ExitSuccess
-	lineNum := 1
+	// Line numbers and empty lines carry over from file to file
+	cf := &catFormatter{o: &o, w: bufio.NewWriter(ctx.Stdout), lineStart: true}
 
 	for _, file := range files {
+		if ctx.Canceled() {
+			return utils.ExitFailure
+		}
+
 		var r io.Reader
 		var closer io.Closer
 
 		if file == "-" {
-			r = os.Stdin
+			r = ctx.Stdin
 		} else {
-			f, err := os.Open(file)
+			f, err := ctx.Files().Open(ctx.Path(file))
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "cat: %s: %v\n", file, err)
+				fmt.Fprintf(ctx.Stderr, "cat: %s: %v\n", file, pathErr(err))
 				exitCode = utils.ExitFailure
 				continue
 			}
@@ -83,38 +121,15 @@ func Cat(args []string) int {
 			closer = f
 		}
 
-		if !numberLines && !numberNonBlank {
+		if !o.formatted() {
 			// Fast path for raw output (supports binary files)
-			_, err := io.Copy(os.Stdout, r)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "cat: %s: %v\n", file, err)
-				exitCode = utils.ExitFailure
-			}
+			_, err = io.Copy(ctx.Stdout, r)
 		} else {
-			// Line numbering path
-			scanner := bufio.NewScanner(r)
-			buf := make([]byte, 0, 64*1024)
-			scanner.Buffer(buf, 1024*1024)
-
-			for scanner.Scan() {
-				line := scanner.Text()
-				if numberNonBlank {
-					if line != "" {
-						fmt.Printf("%6d\t%s\n", lineNum, line)
-						lineNum++
-					} else {
-						fmt.Println()
-					}
-				} else if numberLines {
-					fmt.Printf("%6d\t%s\n", lineNum, line)
-					lineNum++
-				}
-			}
-
-			if err := scanner.Err(); err != nil {
-				fmt.Fprintf(os.Stderr, "cat: %s: %v\n", file, err)
-				exitCode = utils.ExitFailure
-			}
+			err = cf.copy(r)
+		}
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "cat: %s: %v\n", file, pathErr(err))
+			exitCode = utils.ExitFailure
 		}
 
 		if closer != nil {
@@ -125,20 +140,131 @@ func Cat(args []string) int {
 	return exitCode
 }
 
-func printCatHelp() {
-	fmt.Println(`Usage: cat [OPTION]... [FILE]...
+// catFormatter formats input for cat options that change it. It works
+// a byte at a time, so lines can be of any length.
+type catFormatter struct {
+	o         *catOptions
+	w         *bufio.Writer
+	lineNum   int
+	lineStart bool // at the start of a line
+	blanks    int  // empty lines in a row so far
+	cr        bool // a carriage return is held until the next byte
+	buf       [32 * 1024]byte
+}
+
+// copy formats r to the output. What each read brings is written out
+// before the next, so that input from a pipe is not held up.
+func (cf *catFormatter) copy(r io.Reader) error {
+	for {
+		n, err := r.Read(cf.buf[:])
+		for _, c := range cf.buf[:n] {
+			cf.put(c)
+		}
+		if ferr := cf.w.Flush(); ferr != nil {
+			return ferr
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	// A carriage return at the end of a file ends no line
+	if cf.cr {
+		cf.cr = false
+		cf.content('\r')
+	}
+	return cf.w.Flush()
+}
 
-Concatenate FILE(s) to standard output.
+// put formats the byte c.
+func (cf *catFormatter) put(c byte) {
+	if cf.cr {
+		cf.cr = false
+		if c == '\n' {
+			cf.endLine(true)
+			return
+		}
+		cf.content('\r')
+	}
+	switch c {
+	case '\n':
+		cf.endLine(false)
+	case '\r':
+		// Held to see whether it ends the line
+		cf.cr = true
+	default:
+		cf.content(c)
+	}
+}
 
-With no FILE, or when FILE is -, read standard input.
+// content formats a byte within a line, numbering the line first if it
+// is the first byte.
+func (cf *catFormatter) content(c byte) {
+	o, w := cf.o, cf.w
+	if cf.lineStart {
+		cf.lineStart, cf.blanks = false, 0
+		if o.numberLines || o.numberNonBlank {
+			cf.number()
+		}
+	}
+	switch {
+	case c == '\t' && o.showTabs:
+		w.WriteString("^I")
+	case c == '\t' || !o.showNonprinting:
+		w.WriteByte(c)
+	default:
+		if c >= 128 {
+			w.WriteString("M-")
+			c -= 128
+		}
+		switch {
+		case c < 32:
+			w.WriteByte('^')
+			w.WriteByte(c + 64)
+		case c == 127:
+			w.WriteString("^?")
+		default:
+			w.WriteByte(c)
+		}
+	}
+}
 
-Options:
-  -b, --number-nonblank    number nonempty output lines
-  -n, --number             number all output lines
-  --help                   display this help and exit
+// endLine ends a line, with a carriage return before the newline if
+// crlf. The line is empty if nothing came before.
+func (cf *catFormatter) endLine(crlf bool) {
+	o, w := cf.o, cf.w
+	visible := o.showEnds || o.showNonprinting || o.showCRLF
+	if cf.lineStart {
+		cf.blanks++
+		if o.squeezeBlank && cf.blanks > 1 {
+			return
+		}
+		if o.numberLines {
+			cf.number()
+		}
+	}
+	if crlf {
+		if visible {
+			w.WriteString("^M")
+		} else {
+			w.WriteByte('\r')
+		}
+	}
+	if o.showEnds {
+		w.WriteByte('$')
+	}
+	w.WriteByte('\n')
+	cf.lineStart = true
+}
 
-Examples:
-  cat file.txt
-  cat -n file.txt
-  type file.txt | winux cat -n`)
+// number prints the number of the next line.
+func (cf *catFormatter) number() {
+	cf.lineNum++
+	s := strconv.Itoa(cf.lineNum)
+	for i := len(s); i < 6; i++ {
+		cf.w.WriteByte(' ')
+	}
+	cf.w.WriteString(s)
+	cf.w.WriteByte('\t')
 }
@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/CRTYPUBG/winux/internal/conformance"
+	"github.com/CRTYPUBG/winux/internal/core"
+)
+
+// TestConformance runs the golden-file cases in testdata/conformance.
+// Run it with -update to rewrite their expected results.
+func TestConformance(t *testing.T) {
+	conformance.Run(t, "testdata/conformance", map[string]core.CommandFunc{
+		"cat":   Cat,
+		"echo":  Echo,
+		"grep":  Grep,
+		"ls":    Ls,
+		"mkdir": Mkdir,
+		"pwd":   Pwd,
+		"rm":    Rm,
+		"touch": Touch,
+	})
+}
@@ -2,61 +2,70 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
-// Echo implements the echo command.
-// Usage: echo [-n] [-e] [string...]
-func Echo(args []string) int {
-	// Parse flags
-	noNewline := false      // -n: no trailing newline
-	interpretEscapes := false // -e: interpret escape sequences
+// echoOptions holds the parsed echo flags.
+type echoOptions struct {
+	noNewline        bool // -n: no trailing newline
+	interpretEscapes bool // -e: interpret escape sequences
+}
 
-	var parts []string
-	flagsDone := false
+func newEchoFlags(o *echoOptions) *flags.FlagSet {
+	fs := flags.New("echo", "[OPTION]... [STRING]...")
+	fs.Description = "Echo the STRING(s) to standard output."
+	fs.Lenient = true
+	fs.Bool(&o.noNewline, "n", "do not output the trailing newline")
+	fs.Bool(&o.interpretEscapes, "e", "enable interpretation of backslash escapes")
+	fs.Func("E", "", "disable interpretation of backslash escapes (default)", func(string) error {
+		o.interpretEscapes = false
+		return nil
+	})
+	fs.Footer = `Escape sequences (with -e):
+  \\    backslash
+  \n    new line
+  \t    horizontal tab
+  \r    carriage return`
+	fs.Examples = []string{
+		"echo Hello World",
+		`echo -n "no newline"`,
+		`echo -e "line1\nline2"`,
+	}
+	return fs
+}
 
-	for _, arg := range args {
-		if !flagsDone && strings.HasPrefix(arg, "-") && len(arg) > 1 {
-			allFlags := true
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'n':
-					noNewline = true
-				case 'e':
-					interpretEscapes = true
-				case 'E':
-					interpretEscapes = false
-				case '-':
-					// -- ends flag processing
-					flagsDone = true
-				default:
-					allFlags = false
-				}
-			}
-			if allFlags && arg != "--" {
-				continue
-			}
-			if arg == "--" {
-				flagsDone = true
-				continue
-			}
-		}
-		flagsDone = true
-		parts = append(parts, arg)
+// EchoFlags returns the echo flag set, for help and documentation.
+func EchoFlags() *flags.FlagSet {
+	return newEchoFlags(&echoOptions{})
+}
+
+// Echo implements the echo command.
+// Usage: echo [-n] [-e] [string...]
+func Echo(ctx *core.Context, args []string) int {
+	var o echoOptions
+	fs := newEchoFlags(&o)
+	parts, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	output := strings.Join(parts, " ")
 
-	if interpretEscapes {
+	if o.interpretEscapes {
 		output = interpretEscapeSequences(output)
 	}
 
-	if noNewline {
-		fmt.Print(output)
-	} else {
-		fmt.Println(output)
+	if !o.noNewline {
+		output += "\n"
+	}
+	if _, err := io.WriteString(ctx.Stdout, output); err != nil {
+		fmt.Fprintf(ctx.Stderr, "echo: write error: %v\n", err)
+		return utils.ExitFailure
 	}
 
 	return utils.ExitSuccess
@@ -106,25 +115,3 @@ func interpretEscapeSequences(s string) string {
 	}
 	return result.String()
 }
-
-func printEchoHelp() {
-	fmt.Println(`Usage: echo [OPTION]... [STRING]...
-
-Echo the STRING(s) to standard output.
-
-Options:
-  -n    do not output the trailing newline
-  -e    enable interpretation of backslash escapes
-  -E    disable interpretation of backslash escapes (default)
-
-Escape sequences (with -e):
-  \\    backslash
-  \n    new line
-  \t    horizontal tab
-  \r    carriage return
-
-Examples:
-  echo Hello World
-  echo -n "no newline"
-  echo -e "line1\nline2"`)
-}
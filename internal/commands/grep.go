@@ -1,232 +1,595 @@
 package commands
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
-	"regexp"
+	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
 
-	winuxio "github.com/CRTYPUBG/winux/internal/io"
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/gitignore"
+	"github.com/CRTYPUBG/winux/internal/glob"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/term"
 	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
 )
 
-// Grep implements the grep command.
-// Usage: grep [-i] [-v] [-n] [-c] [-l] [-E] pattern [file...]
-func Grep(args []string) int {
-	// Parse flags
-	ignoreCase := false    // -i: case insensitive
-	invertMatch := false   // -v: invert match
-	showLineNum := false   // -n: show line numbers
-	countOnly := false     // -c: count matches only
-	filesOnly := false     // -l: show filenames only
-	extendedRegex := false // -E: extended regex (always enabled in Go)
-
-	var pattern string
-	var files []string
-
-	i := 0
-	for i < len(args) {
-		arg := args[i]
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'i':
-					ignoreCase = true
-				case 'v':
-					invertMatch = true
-				case 'n':
-					showLineNum = true
-				case 'c':
-					countOnly = true
-				case 'l':
-					filesOnly = true
-				case 'E':
-					extendedRegex = true
-				case 'e':
-					// -e pattern (next arg is pattern)
-					i++
-					if i < len(args) {
-						pattern = args[i]
-					}
-				default:
-					fmt.Fprintf(os.Stderr, "grep: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
-			}
-		} else if arg == "--ignore-case" {
-			ignoreCase = true
-		} else if arg == "--invert-match" {
-			invertMatch = true
-		} else if arg == "--line-number" {
-			showLineNum = true
-		} else if arg == "--count" {
-			countOnly = true
-		} else if arg == "--files-with-matches" {
-			filesOnly = true
-		} else if arg == "--extended-regexp" {
-			extendedRegex = true
-		} else if arg == "--help" {
-			printGrepHelp()
-			return utils.ExitSuccess
-		} else if pattern == "" {
-			pattern = arg
-		} else {
-			files = append(files, arg)
-		}
-		i++
-	}
-
-	_ = extendedRegex // Go regex is always extended
-
-	if pattern == "" {
-		fmt.Fprintln(os.Stderr, "grep: missing pattern")
-		printGrepHelp()
-		return utils.ExitUsageError
+// grepOptions holds the parsed grep flags.
+type grepOptions struct {
+	ignoreCase   bool       // -i: case insensitive
+	invertMatch  bool       // -v: invert match
+	showLineNum  bool       // -n: show line numbers
+	countOnly    bool       // -c: count matches only
+	filesOnly    bool       // -l: show filenames only
+	syntax       grepSyntax // -E, -G or -F
+	patterns     []string   // -e: patterns
+	patternFiles []string   // -f: files of patterns, one per line
+	recursive    bool       // -r: search directories
+	dereference  bool       // -R: follow all symbolic links while recursing
+	include      []string   // --include: globs of file names to search
+	exclude      []string   // --exclude: globs of file names to skip
+	excludeDir   []string   // --exclude-dir: globs of directory names to skip
+	binaryFiles  string     // binary, text or without-match
+	gitignore    bool       // --gitignore: skip what .gitignore files ignore
+	after        int        // -A: lines of trailing context; -1 for -C
+	before       int        // -B: lines of leading context; -1 for -C
+	context      int        // -C: lines of context
+	onlyMatching bool       // -o: print only the matches
+	wordRegexp   bool       // -w: match whole words
+	lineRegexp   bool       // -x: match whole lines
+	maxCount     int        // -m: stop after this many selected lines; -1 for no limit
+	byteOffset   bool       // -b: print byte offsets
+	withFilename bool       // -H: always print file names
+	noFilename   bool       // -h: never print file names
+	quiet        bool       // -q: print nothing, stop at the first match
+	noMessages   bool       // -s: suppress messages about unreadable files
+	null         bool       // -Z: end file names with a NUL byte
+	color        term.When
+	groupSep     string // --group-separator: printed between context groups
+	noGroupSep   bool
+	threads      int // -j: files searched at once
+}
+
+// Structured output of grep: a grepMatch for each match, or for each
+// selected line with -v; a grepCount per file with -c; a grepFile per
+// file with -l.
+type (
+	grepMatch struct {
+		File   string `json:"file"`
+		Line   int    `json:"line"`
+		Column int    `json:"column,omitempty"` // 1-based, in characters
+		Match  string `json:"match,omitempty"`
+		Text   string `json:"text"` // the whole line
+	}
+	grepCount struct {
+		File  string `json:"file"`
+		Count int    `json:"count"`
+	}
+	grepFile struct {
+		File string `json:"file"`
+	}
+)
+
+func newGrepFlags(o *grepOptions) *flags.FlagSet {
+	fs := flags.New("grep", "[OPTION]... PATTERNS [FILE]...")
+	fs.Description = `Search for PATTERNS in each FILE. PATTERNS is one or more patterns
+separated by newlines, and a line is selected if it matches any.
+When FILE is -, read standard input. With -r and no FILE, search the
+working directory.`
+	fs.Bool(&o.ignoreCase, "i,ignore-case", "ignore case distinctions")
+	fs.Bool(&o.invertMatch, "v,invert-match", "select non-matching lines")
+	fs.Bool(&o.showLineNum, "n,line-number", "print line number with output lines")
+	fs.Bool(&o.countOnly, "c,count", "print only a count of matching lines")
+	fs.Bool(&o.filesOnly, "l,files-with-matches", "print only names of files with matches")
+	fs.Func("E,extended-regexp", "", "PATTERNS are extended regular expressions (default)", grepSyntaxFlag(&o.syntax, extendedSyntax))
+	fs.Func("G,basic-regexp", "", "PATTERNS are basic regular expressions", grepSyntaxFlag(&o.syntax, basicSyntax))
+	fs.Func("F,fixed-strings", "", "PATTERNS are strings", grepSyntaxFlag(&o.syntax, fixedSyntax))
+	fs.Func("e,regexp", "PATTERNS", "use PATTERNS for matching; may be repeated", func(v string) error {
+		o.patterns = append(o.patterns, strings.Split(v, "\n")...)
+		return nil
+	})
+	fs.StringList(&o.patternFiles, "f,file", "FILE", "take PATTERNS from FILE, one per line; - for\nstandard input")
+	fs.Bool(&o.recursive, "r,recursive", "search directories recursively, following symbolic\nlinks only if they are on the command line")
+	fs.Func("R,dereference-recursive", "", "likewise, but follow all symbolic links", func(string) error {
+		o.recursive, o.dereference = true, true
+		return nil
+	})
+	fs.StringList(&o.include, "include", "GLOB", "search only files whose base name matches GLOB")
+	fs.StringList(&o.exclude, "exclude", "GLOB", "skip files whose base name matches GLOB")
+	fs.StringList(&o.excludeDir, "exclude-dir", "GLOB", "skip directories whose base name matches GLOB")
+	fs.Bool(&o.gitignore, "gitignore", "skip files ignored by .gitignore files, and .git\ndirectories, while recursing")
+	fs.Func("binary-files", "TYPE", "assume binary files are TYPE: binary, text or\nwithout-match", func(v string) error {
+		switch v {
+		case "binary", "text", "without-match":
+			o.binaryFiles = v
+			return nil
+		}
+		return fmt.Errorf("invalid argument '%s'", v)
+	})
+	fs.Func("a,text", "", "equivalent to --binary-files=text", func(string) error {
+		o.binaryFiles = "text"
+		return nil
+	})
+	fs.Func("I", "", "equivalent to --binary-files=without-match", func(string) error {
+		o.binaryFiles = "without-match"
+		return nil
+	})
+	fs.Func("A,after-context", "NUM", "print NUM lines of trailing context", contextLength(&o.after))
+	fs.Func("B,before-context", "NUM", "print NUM lines of leading context", contextLength(&o.before))
+	fs.Func("C,context", "NUM", "print NUM lines of output context", contextLength(&o.context))
+	fs.String(&o.groupSep, "group-separator", "SEP", "print SEP between groups of context lines (default --)")
+	fs.Bool(&o.noGroupSep, "no-group-separator", "do not print a separator between groups of context lines")
+	fs.Bool(&o.onlyMatching, "o,only-matching", "show only the nonempty parts of lines that match")
+	fs.Bool(&o.wordRegexp, "w,word-regexp", "match only whole words")
+	fs.Bool(&o.lineRegexp, "x,line-regexp", "match only whole lines")
+	fs.Int(&o.maxCount, "m,max-count", "NUM", "stop after NUM selected lines")
+	fs.Bool(&o.byteOffset, "b,byte-offset", "print the byte offset with output lines")
+	fs.Func("H,with-filename", "", "print the file name for each match", func(string) error {
+		o.withFilename, o.noFilename = true, false
+		return nil
+	})
+	fs.Func("h,no-filename", "", "suppress the file name prefix on output", func(string) error {
+		o.withFilename, o.noFilename = false, true
+		return nil
+	})
+	fs.Bool(&o.quiet, "q,quiet,silent", "suppress all normal output and stop at the first match")
+	fs.Bool(&o.noMessages, "s,no-messages", "suppress error messages about unreadable files")
+	fs.Bool(&o.null, "Z,null", "print a NUL byte after file names")
+	fs.Int(&o.threads, "j,threads", "NUM", "search NUM files at once (default: one per CPU)")
+	fs.Func("color", "WHEN", "highlight matches, file names and line numbers:\nalways, auto or never; GREP_COLORS chooses the colours", func(v string) error {
+		w, err := term.ParseWhen(v)
+		o.color = w
+		return err
+	}).OptionalArg("always")
+	fs.Footer = `Files are searched in parallel, but output is in the order of the
+files, as when they are searched one at a time.
+
+Unlike GNU grep, which defaults to -G, patterns are extended regular
+expressions unless -G or -F is given. Large lists of -F strings, as
+from -f, are matched in one pass whatever their number.
+
+A file is binary if it contains a NUL byte. Matches in binary files
+are reported with one "binary file matches" message instead of lines.
+Text starting with a byte order mark is decoded first, so UTF-16 files
+such as those of Windows PowerShell 5 are searched and printed as
+UTF-8. Lines keep their CRLF or LF endings.
+
+Exit status:
+  0  if any matches found
+  1  if no matches found
+  2  if an error occurred, unless -q is given and a match is found`
+	fs.Examples = []string{
+		"grep error log.txt",
+		"grep -i ERROR log.txt",
+		`grep -n "pattern" file1.txt file2.txt`,
+		"type log.txt | winux grep -i error",
+		`grep -rn --include=*.go --gitignore "TODO" .`,
+		"grep -C 2 -w panic *.log",
+		"grep -q error log.txt && echo found",
+		"grep -F -f iocs.txt -r logs",
+		`grep -e warning -e error log.txt`,
+	}
+	return fs
+}
+
+// grepSyntaxFlag returns the setter of -E, -G or -F, of which the last
+// given wins.
+func grepSyntaxFlag(p *grepSyntax, syntax grepSyntax) func(string) error {
+	return func(string) error {
+		*p = syntax
+		return nil
 	}
+}
 
-	// Build regex
-	regexPattern := pattern
-	if ignoreCase {
-		regexPattern = "(?i)" + regexPattern
+// contextLength returns the setter of a context length option.
+func contextLength(p *int) func(string) error {
+	return func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid context length '%s'", v)
+		}
+		*p = n
+		return nil
 	}
+}
 
-	re, err := regexp.Compile(regexPattern)
+// GrepFlags returns the grep flag set, for help and documentation.
+func GrepFlags() *flags.FlagSet {
+	return newGrepFlags(&grepOptions{})
+}
+
+// Grep implements the grep command.
+// Usage: grep [-ivnclEFGwxoqsHhbZrRaI] [-A|-B|-C NUM] [-m NUM] [-j NUM] [-e PATTERNS|-f FILE]... [PATTERNS] [file...]
+func Grep(ctx *core.Context, args []string) int {
+	o := grepOptions{after: -1, before: -1, maxCount: -1, groupSep: "--"}
+	fs := newGrepFlags(&o)
+	files, err := fs.Parse(args)
 	if err != nil {
-		// Fall back to literal string matching
-		re = nil
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
-	// If no files, read from stdin
+	patterns := o.patterns
+	if len(o.patterns) == 0 && len(o.patternFiles) == 0 {
+		if len(files) == 0 {
+			return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing pattern"))
+		}
+		patterns, files = strings.Split(files[0], "\n"), files[1:]
+	}
+	for _, name := range o.patternFiles {
+		p, err := readPatterns(ctx, name)
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "grep: %s: %v\n", name, pathErr(err))
+			return utils.ExitUsageError
+		}
+		patterns = append(patterns, p...)
+	}
+	m, err := newGrepMatcher(&o, patterns)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "grep: %v\n", err)
+		return utils.ExitUsageError
+	}
+	if o.after < 0 {
+		o.after = max(o.context, 0)
+	}
+	if o.before < 0 {
+		o.before = max(o.context, 0)
+	}
+	if o.threads < 1 {
+		o.threads = runtime.GOMAXPROCS(0)
+	}
+
+	// If no files, read from stdin, or search the working directory
+	// with -r
+	implicitDir := false
 	if len(files) == 0 {
-		if winuxio.IsPiped() {
+		switch {
+		case o.recursive:
+			files, implicitDir = []string{"."}, true
+		case ctx.StdinPiped():
 			files = []string{"-"}
-		} else {
-			fmt.Fprintln(os.Stderr, "grep: no input files")
+		default:
+			fmt.Fprintln(ctx.Stderr, "grep: no input files")
 			return utils.ExitUsageError
 		}
 	}
 
-	matchFound := false
-	multipleFiles := len(files) > 1
+	g := &grepper{ctx: ctx, o: &o, m: m, showFileName: len(files) > 1 || o.recursive}
+	switch {
+	case o.withFilename:
+		g.showFileName = true
+	case o.noFilename:
+		g.showFileName = false
+	}
+	if ctx.Output.Structured() {
+		g.out = output.NewList(ctx.Stdout, ctx.Output)
+		defer g.out.Close()
+	} else if color, restore := ctx.UseColor(ctx.Stdout, o.color); color {
+		defer restore()
+		g.color, g.colors = true, parseGrepColors(ctx.Getenv("GREP_COLORS"))
+	}
+
+	g.run(files, implicitDir)
+
+	switch {
+	case g.failed && !(o.quiet && g.matched):
+		return utils.ExitUsageError
+	case g.matched:
+		return utils.ExitSuccess
+	}
+	return utils.ExitFailure // No matches found
+}
+
+// readPatterns returns the lines of the named file, or of standard
+// input for "-", as patterns. An empty file has none.
+func readPatterns(ctx *core.Context, name string) ([]string, error) {
+	var data []byte
+	var err error
+	if name == "-" {
+		data, err = io.ReadAll(ctx.Stdin)
+	} else {
+		data, err = vfs.ReadFile(ctx.Files(), ctx.Path(name))
+	}
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines, nil
+}
+
+// grepper searches files for one run of grep. The files are found by
+// the goroutine calling run and searched by o.threads workers, and
+// their results are printed by another goroutine.
+type grepper struct {
+	ctx          *core.Context
+	o            *grepOptions
+	m            grepMatcher
+	out          *output.List // structured output, or nil for text
+	color        bool
+	colors       grepColors
+	showFileName bool
+
+	work  chan *grepJob // to the workers
+	queue chan *grepJob // to the printer, in order
+	done  atomic.Bool   // set by -q at the first match
+	stdin sync.Mutex    // held by the worker reading standard input
+
+	// Set by the printer
+	matched bool // whether a line was selected
+	failed  bool // whether a file could not be searched
+	printed bool // whether a line was printed, for group separators
+}
+
+// run searches the files and waits for the results to be printed.
+func (g *grepper) run(files []string, implicitDir bool) {
+	g.work = make(chan *grepJob)
+	// Enough to keep the workers busy while the printer waits for a
+	// long file, and no more, so results do not pile up in memory
+	g.queue = make(chan *grepJob, 4*g.o.threads)
+
+	var workers sync.WaitGroup
+	for i := 0; i < g.o.threads; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for j := range g.work {
+				g.searchJob(j)
+				close(j.finished)
+			}
+		}()
+	}
+	printed := make(chan struct{})
+	go func() {
+		g.print()
+		close(printed)
+	}()
 
 	for _, file := range files {
-		var reader io.Reader
-		var fileName string
-
-		if file == "-" {
-			reader = os.Stdin
-			fileName = "(standard input)"
-		} else {
-			f, err := os.Open(file)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "grep: %s: %v\n", file, err)
-				continue
+		if g.ctx.Canceled() || g.done.Load() {
+			break
+		}
+		g.searchOperand(file, implicitDir)
+	}
+	close(g.work)
+	close(g.queue)
+	workers.Wait()
+	<-printed
+}
+
+// submit queues the search of a file.
+func (g *grepper) submit(j *grepJob) {
+	g.queue <- j
+	g.work <- j
+}
+
+// print prints the results of the jobs in the order they were
+// submitted. The job at the head of the queue writes its text output
+// itself as it searches, so that lines from a pipe are not held up.
+// After a match with -q, nothing more is printed.
+func (g *grepper) print() {
+	ctx := g.ctx
+	for j := range g.queue {
+		if g.done.Load() {
+			<-j.finished
+			continue
+		}
+		j.goLive(ctx.Stdout, ctx.Stderr, g.printed, g.sprint(g.colors.separator, g.o.groupSep))
+		<-j.finished
+		for _, v := range j.items {
+			g.out.Add(v)
+		}
+		g.printed = g.printed || j.printed
+		g.failed = g.failed || j.failed
+		if j.matched {
+			g.matched = true
+			if g.o.quiet {
+				g.done.Store(true)
 			}
-			defer f.Close()
-			reader = f
-			fileName = file
 		}
+	}
+}
 
-		found := grepReader(reader, fileName, re, pattern, ignoreCase, invertMatch, showLineNum, countOnly, filesOnly, multipleFiles)
-		if found {
-			matchFound = true
+// searchJob searches the file of j, unless grep is already done.
+func (g *grepper) searchJob(j *grepJob) {
+	if g.done.Load() || g.ctx.Canceled() {
+		return
+	}
+	if j.stdin {
+		// For "grep PATTERN - -", the second search reads nothing
+		g.stdin.Lock()
+		defer g.stdin.Unlock()
+		g.search(j, g.ctx.Stdin, j.name)
+		return
+	}
+	f, err := g.ctx.Files().Open(g.ctx.Path(j.name))
+	if err != nil {
+		j.fail(g.o, "%s: %v", j.name, pathErr(err))
+		return
+	}
+	defer f.Close()
+	g.search(j, f, j.name)
+}
+
+// warn reports trouble met while finding files, unless -s, in order
+// with the results of the files.
+func (g *grepper) warn(format string, args ...any) {
+	j := newGrepJob("")
+	j.warn(g.o, format, args...)
+	close(j.finished)
+	g.queue <- j
+}
+
+// fail is warn for errors, which make grep exit with status 2.
+func (g *grepper) fail(format string, args ...any) {
+	j := newGrepJob("")
+	j.fail(g.o, format, args...)
+	close(j.finished)
+	g.queue <- j
+}
+
+// searchOperand searches a FILE operand: standard input for "-", and
+// the files in a directory with -r. implicit is set for the working
+// directory searched when there is no operand, whose name is left out
+// of output.
+func (g *grepper) searchOperand(file string, implicit bool) {
+	ctx := g.ctx
+	if file == "-" {
+		j := newGrepJob("(standard input)")
+		j.stdin = true
+		g.submit(j)
+		return
+	}
+
+	info, err := ctx.Files().Stat(ctx.Path(file))
+	if err == nil && info.IsDir() {
+		switch {
+		case !g.o.recursive:
+			g.fail("%s: Is a directory", file)
+		case implicit:
+			g.walk("", "", nil, []fs.FileInfo{info})
+		case !matchGlobs(g.o.excludeDir, filepath.Base(file)):
+			g.walk(file, "", nil, []fs.FileInfo{info})
 		}
+		return
 	}
+	if g.included(filepath.Base(file)) {
+		g.submit(newGrepJob(file))
+	}
+}
 
-	if matchFound {
-		return utils.ExitSuccess
+// included reports whether --include and --exclude let grep search a
+// file with the given base name.
+func (g *grepper) included(name string) bool {
+	if len(g.o.include) > 0 && !matchGlobs(g.o.include, name) {
+		return false
 	}
-	return utils.ExitFailure // No matches found
+	return !matchGlobs(g.o.exclude, name)
 }
 
-func grepReader(reader io.Reader, fileName string, re *regexp.Regexp, pattern string, ignoreCase, invertMatch, showLineNum, countOnly, filesOnly, showFileName bool) bool {
-	scanner := bufio.NewScanner(reader)
-	buf := make([]byte, 0, 64*1024)
-	scanner.Buffer(buf, 1024*1024)
-
-	lineNum := 0
-	matchCount := 0
-	fileHasMatch := false
-
-	for scanner.Scan() {
-		lineNum++
-		line := scanner.Text()
-
-		var match bool
-		if re != nil {
-			match = re.MatchString(line)
-		} else {
-			// Literal string match
-			if ignoreCase {
-				match = strings.Contains(strings.ToLower(line), strings.ToLower(pattern))
-			} else {
-				match = strings.Contains(line, pattern)
-			}
+// matchGlobs reports whether name matches one of the globs. Unlike in
+// the shell, * matches a leading ".", as in GNU grep.
+func matchGlobs(globs []string, name string) bool {
+	for _, p := range globs {
+		if ok, _ := glob.Match(p, name); ok {
+			return true
 		}
+	}
+	return false
+}
 
-		if invertMatch {
-			match = !match
+// walk searches the directory dir, as named in output ("" for the
+// working directory when grep -r has no operand), and its
+// subdirectories. rel is dir relative to the operand for .gitignore
+// patterns, collected in ig; parents are the directories being walked,
+// to detect loops through symbolic links.
+func (g *grepper) walk(dir, rel string, ig *gitignore.Ignore, parents []fs.FileInfo) {
+	ctx := g.ctx
+	osDir := ctx.Path(dir)
+	if dir == "" {
+		osDir = ctx.Path(".")
+	}
+	entries, err := ctx.Files().ReadDir(osDir)
+	if err != nil {
+		g.fail("%s: %v", dir, pathErr(err))
+		return
+	}
+	if g.o.gitignore {
+		if data, err := vfs.ReadFile(ctx.Files(), filepath.Join(osDir, gitignore.FileName)); err == nil {
+			ig = ig.Add(rel, data)
 		}
+	}
 
-		if match {
-			matchCount++
-			fileHasMatch = true
+	for _, e := range entries {
+		if ctx.Canceled() || g.done.Load() {
+			return
+		}
+		name := e.Name()
+		path := name
+		if dir != "" {
+			path = joinPath(dir, name)
+		}
+		relPath := name
+		if rel != "" {
+			relPath = rel + "/" + name
+		}
 
-			if filesOnly {
-				fmt.Println(fileName)
-				return true // Only print filename once
+		// -r only follows links named on the command line.
+		info, err := e.Info()
+		if err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			if !g.o.dereference {
+				continue
 			}
+			info, err = ctx.Files().Stat(ctx.Path(path))
+		}
+		if err != nil {
+			g.fail("%s: %v", path, pathErr(err))
+			continue
+		}
 
-			if !countOnly {
-				prefix := ""
-				if showFileName {
-					prefix = fileName + ":"
-				}
-				if showLineNum {
-					prefix += fmt.Sprintf("%d:", lineNum)
-				}
-				fmt.Printf("%s%s\n", prefix, line)
+		switch {
+		case info.IsDir():
+			if matchGlobs(g.o.excludeDir, name) ||
+				g.o.gitignore && (name == ".git" || ig.Match(relPath, true)) {
+				continue
+			}
+			if loop(parents, info) {
+				g.warn("warning: %s: recursive directory loop", path)
+				continue
 			}
+			g.walk(path, relPath, ig, append(parents[:len(parents):len(parents)], info))
+		case !info.Mode().IsRegular():
+			// Devices, pipes and sockets could block.
+		case g.o.gitignore && ig.Match(relPath, false), !g.included(name):
+		default:
+			g.submit(newGrepJob(path))
 		}
 	}
+}
 
-	if countOnly {
-		if showFileName {
-			fmt.Printf("%s:%d\n", fileName, matchCount)
-		} else {
-			fmt.Printf("%d\n", matchCount)
+// loop reports whether the directory info is one of parents.
+func loop(parents []fs.FileInfo, info fs.FileInfo) bool {
+	for _, p := range parents {
+		if os.SameFile(p, info) {
+			return true
 		}
 	}
-
-	return fileHasMatch
+	return false
 }
 
-func printGrepHelp() {
-	fmt.Println(`Usage: grep [OPTION]... PATTERN [FILE]...
-
-Search for PATTERN in each FILE.
-When FILE is -, read standard input.
-
-Options:
-  -i, --ignore-case         ignore case distinctions
-  -v, --invert-match        select non-matching lines
-  -n, --line-number         print line number with output lines
-  -c, --count               print only a count of matching lines
-  -l, --files-with-matches  print only names of files with matches
-  -E, --extended-regexp     interpret pattern as extended regex
-  -e PATTERN                use PATTERN for matching
-  --help                    display this help and exit
-
-Exit status:
-  0  if any matches found
-  1  if no matches found
-  2  if error occurred
-
-Examples:
-  grep error log.txt
-  grep -i ERROR log.txt
-  grep -n "pattern" file1.txt file2.txt
-  type log.txt | winux grep -i error`)
+// addMatches appends to items a grepMatch for each non-empty match in
+// the selected line, or one for the whole line if there is none, as
+// with -v.
+func (g *grepper) addMatches(items []any, fileName string, lineNum int, line string) []any {
+	added := false
+	if !g.o.invertMatch {
+		for _, m := range g.m.find(line) {
+			if m[0] == m[1] {
+				continue
+			}
+			items = append(items, grepMatch{
+				File:   fileName,
+				Line:   lineNum,
+				Column: utf8.RuneCountInString(line[:m[0]]) + 1,
+				Match:  line[m[0]:m[1]],
+				Text:   line,
+			})
+			added = true
+		}
+	}
+	if !added {
+		items = append(items, grepMatch{File: fileName, Line: lineNum, Text: line})
+	}
+	return items
 }
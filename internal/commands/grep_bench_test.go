@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+)
+
+// BenchmarkGrepTree searches a tree of log files with one worker and
+// with one per CPU.
+func BenchmarkGrepTree(b *testing.B) {
+	dir := b.TempDir()
+	var size int64
+	for d := 0; d < 20; d++ {
+		sub := filepath.Join(dir, fmt.Sprintf("host%02d", d))
+		if err := os.Mkdir(sub, 0o755); err != nil {
+			b.Fatal(err)
+		}
+		for f := 0; f < 20; f++ {
+			var log strings.Builder
+			for n := 0; n < 800; n++ {
+				level := "INFO"
+				if n%97 == 0 {
+					level = "ERROR"
+				}
+				fmt.Fprintf(&log, "2024-01-15T10:%02d:%02dZ %s request %d served from cache in %dms\n", n/60%60, n%60, level, n, n%250)
+			}
+			name := filepath.Join(sub, fmt.Sprintf("app%02d.log", f))
+			if err := os.WriteFile(name, []byte(log.String()), 0o644); err != nil {
+				b.Fatal(err)
+			}
+			size += int64(log.Len())
+		}
+	}
+
+	threads := []int{1}
+	if n := runtime.GOMAXPROCS(0); n > 1 {
+		threads = append(threads, n)
+	}
+	for _, threads := range threads {
+		b.Run("threads="+strconv.Itoa(threads), func(b *testing.B) {
+			b.SetBytes(size)
+			for i := 0; i < b.N; i++ {
+				ctx := core.NewContext()
+				ctx.Dir = dir
+				ctx.Stdout, ctx.Stderr = io.Discard, io.Discard
+				if code := Grep(ctx, []string{"-rn", "-j", strconv.Itoa(threads), "ERROR request [0-9]+", "."}); code != 0 {
+					b.Fatalf("grep exited with %d", code)
+				}
+			}
+		})
+	}
+}
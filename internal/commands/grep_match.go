@@ -0,0 +1,290 @@
+package commands
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/CRTYPUBG/winux/internal/ahocorasick"
+)
+
+// grepSyntax is how grep reads patterns.
+type grepSyntax int
+
+const (
+	extendedSyntax grepSyntax = iota // -E, the default
+	basicSyntax                      // -G
+	fixedSyntax                      // -F
+)
+
+// grepMatcher finds where lines match the patterns.
+type grepMatcher interface {
+	// match reports whether line matches.
+	match(line string) bool
+
+	// find returns the start and end of each match in line, leftmost
+	// first and not overlapping, some possibly empty.
+	find(line string) [][]int
+}
+
+// newGrepMatcher returns the matcher for patterns, read as o.syntax.
+// Fixed strings are matched with an Aho-Corasick automaton, unless -i
+// needs Unicode case folding, which only regular expressions do.
+func newGrepMatcher(o *grepOptions, patterns []string) (grepMatcher, error) {
+	if o.syntax == fixedSyntax && (!o.ignoreCase || isASCII(patterns)) {
+		return newFixedMatcher(o, patterns), nil
+	}
+
+	exprs := make([]string, len(patterns))
+	for i, p := range patterns {
+		switch o.syntax {
+		case fixedSyntax:
+			p = regexp.QuoteMeta(p)
+		case basicSyntax:
+			var err error
+			if p, err = basicToExtended(p); err != nil {
+				return nil, err
+			}
+		}
+		// Compiled alone, so an error points at the faulty pattern
+		if _, err := regexp.Compile(p); err != nil {
+			return nil, err
+		}
+		exprs[i] = "(?:" + p + ")"
+	}
+	pattern := strings.Join(exprs, "|")
+	if len(exprs) == 0 {
+		// An empty pattern file matches nothing
+		pattern = `[^\x00-\x{10FFFF}]`
+	}
+
+	m := &regexpMatcher{word: o.wordRegexp && !o.lineRegexp}
+	switch {
+	case o.lineRegexp:
+		pattern = "^(?:" + pattern + ")$"
+	case m.word:
+		pattern = `(` + pattern + `)(?:[^\pL\pN_]|$)`
+	}
+	if o.ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	m.re = regexp.MustCompile(pattern)
+	// POSIX picks the longest of the matches starting at a position.
+	m.re.Longest()
+	return m, nil
+}
+
+// isASCII reports whether all of the strings are ASCII.
+func isASCII(strs []string) bool {
+	for _, s := range strs {
+		for i := 0; i < len(s); i++ {
+			if s[i] >= utf8.RuneSelf {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// regexpMatcher matches a regular expression.
+type regexpMatcher struct {
+	re *regexp.Regexp
+
+	// word is set for -w: the match is group 1 of re, which also
+	// matches the character after it, and must not follow a word
+	// character.
+	word bool
+}
+
+func (m *regexpMatcher) match(line string) bool {
+	if !m.word {
+		return m.re.MatchString(line)
+	}
+	return len(m.find(line)) > 0
+}
+
+func (m *regexpMatcher) find(line string) [][]int {
+	if !m.word {
+		return m.re.FindAllStringIndex(line, -1)
+	}
+	var spans [][]int
+	for _, loc := range m.re.FindAllStringSubmatchIndex(line, -1) {
+		start, end := loc[2], loc[3]
+		if r, _ := utf8.DecodeLastRuneInString(line[:start]); start > 0 && isWordChar(r) {
+			continue
+		}
+		spans = append(spans, []int{start, end})
+	}
+	return spans
+}
+
+// isWordChar reports whether r is part of a word for -w.
+func isWordChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// fixedMatcher matches fixed strings, for -F.
+type fixedMatcher struct {
+	ac    *ahocorasick.Matcher
+	word  bool                // -w
+	lines map[string]struct{} // the patterns, with -x
+	fold  bool                // -i
+}
+
+func newFixedMatcher(o *grepOptions, patterns []string) *fixedMatcher {
+	m := &fixedMatcher{word: o.wordRegexp, fold: o.ignoreCase}
+	if o.lineRegexp {
+		m.lines = make(map[string]struct{}, len(patterns))
+		for _, p := range patterns {
+			m.lines[m.key(p)] = struct{}{}
+		}
+		return m
+	}
+	m.ac = ahocorasick.New(patterns, o.ignoreCase)
+	return m
+}
+
+// key returns s as stored in m.lines.
+func (m *fixedMatcher) key(s string) string {
+	if m.fold {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+func (m *fixedMatcher) match(line string) bool {
+	switch {
+	case m.lines != nil:
+		_, ok := m.lines[m.key(line)]
+		return ok
+	case m.word:
+		return len(m.find(line)) > 0
+	}
+	return m.ac.Contains(line)
+}
+
+func (m *fixedMatcher) find(line string) [][]int {
+	if m.lines != nil {
+		if m.match(line) {
+			return [][]int{{0, len(line)}}
+		}
+		return nil
+	}
+
+	all := m.ac.FindAll(line)
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Start != all[j].Start {
+			return all[i].Start < all[j].Start
+		}
+		return all[i].End > all[j].End
+	})
+	var spans [][]int
+	end := 0
+	for _, a := range all {
+		if a.Start < end || m.word && !isWord(line, a.Start, a.End) {
+			continue
+		}
+		spans = append(spans, []int{a.Start, a.End})
+		end = a.End
+	}
+	return spans
+}
+
+// isWord reports whether line[start:end] is not preceded or followed by
+// a word character.
+func isWord(line string, start, end int) bool {
+	if r, _ := utf8.DecodeLastRuneInString(line[:start]); start > 0 && isWordChar(r) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(line[end:])
+	return end == len(line) || !isWordChar(r)
+}
+
+// basicToExtended translates a POSIX basic regular expression, with the
+// GNU extensions \| \+ \? \< and \>, to the syntax of package regexp.
+// In a basic expression ( ) { } | + and ? are literal unless escaped,
+// and * ^ and $ are literal where they cannot be operators.
+func basicToExtended(p string) (string, error) {
+	var b strings.Builder
+	start := true // at the start of the expression or a group
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case c == '\\' && i+1 < len(p):
+			i++
+			c = p[i]
+			switch {
+			case strings.IndexByte("(){}|+?", c) >= 0:
+				b.WriteByte(c)
+				start = c == '(' || c == '|'
+				continue
+			case c == '<' || c == '>':
+				b.WriteString(`\b`)
+			case '1' <= c && c <= '9':
+				return "", errors.New("back-references are not supported")
+			case strings.IndexByte("wWsSbB", c) >= 0:
+				b.WriteString(`\` + string(c))
+			default:
+				b.WriteString(regexp.QuoteMeta(string(c)))
+			}
+			start = false
+			continue
+		case c == '\\':
+			return "", errors.New("trailing backslash (\\)")
+		case c == '[':
+			end := bracketEnd(p, i)
+			if end < 0 {
+				return "", errors.New("unmatched [, [^, [:, [., or [=")
+			}
+			// A backslash is literal in a bracket expression
+			b.WriteString(strings.ReplaceAll(p[i:end], `\`, `\\`))
+			i = end - 1
+		case c == '*' && start, c == '^' && !start:
+			b.WriteString(`\` + string(c))
+		case c == '$' && !atBasicEnd(p, i+1):
+			b.WriteString(`\$`)
+		case strings.IndexByte("(){}|+?", c) >= 0:
+			b.WriteString(`\` + string(c))
+		default:
+			b.WriteByte(c)
+		}
+		start = c == '^' && start
+	}
+	return b.String(), nil
+}
+
+// bracketEnd returns the index after the bracket expression starting at
+// p[i], or -1 if it is not closed. A ] first in the list is literal, as
+// are the brackets of classes such as [:alpha:].
+func bracketEnd(p string, i int) int {
+	j := i + 1
+	if j < len(p) && p[j] == '^' {
+		j++
+	}
+	if j < len(p) && p[j] == ']' {
+		j++
+	}
+	for ; j < len(p); j++ {
+		switch {
+		case p[j] == ']':
+			return j + 1
+		case p[j] == '[' && j+1 < len(p) && strings.IndexByte(":.=", p[j+1]) >= 0:
+			close := strings.Index(p[j+2:], string(p[j+1])+"]")
+			if close < 0 {
+				return -1
+			}
+			j += 2 + close + 1
+		}
+	}
+	return -1
+}
+
+// atBasicEnd reports whether p[i:] ends a basic expression or a group,
+// where $ is an anchor.
+func atBasicEnd(p string, i int) bool {
+	rest := p[i:]
+	return rest == "" || strings.HasPrefix(rest, `\)`) || strings.HasPrefix(rest, `\|`)
+}
@@ -0,0 +1,387 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/CRTYPUBG/winux/internal/term"
+	"github.com/CRTYPUBG/winux/internal/textio"
+)
+
+// grepColors are the styles of the parts of grep output, as set in
+// GREP_COLORS.
+type grepColors struct {
+	selectedMatch term.Style // ms: matches in selected lines
+	contextMatch  term.Style // mc: matches in context lines
+	selectedLine  term.Style // sl: the rest of selected lines
+	contextLine   term.Style // cx: the rest of context lines
+	fileName      term.Style // fn
+	lineNum       term.Style // ln
+	byteOffset    term.Style // bn
+	separator     term.Style // se
+}
+
+// parseGrepColors returns the default colours of GNU grep overridden by
+// the entries of GREP_COLORS, such as "ms=01;32:fn=34". mt sets both
+// ms and mc; unknown entries are ignored.
+func parseGrepColors(s string) grepColors {
+	c := grepColors{
+		selectedMatch: "01;31",
+		contextMatch:  "01;31",
+		fileName:      "35",
+		lineNum:       "32",
+		byteOffset:    "32",
+		separator:     "36",
+	}
+	for _, entry := range strings.Split(s, ":") {
+		key, value, _ := strings.Cut(entry, "=")
+		style := term.Style(value)
+		switch key {
+		case "mt":
+			c.selectedMatch, c.contextMatch = style, style
+		case "ms":
+			c.selectedMatch = style
+		case "mc":
+			c.contextMatch = style
+		case "sl":
+			c.selectedLine = style
+		case "cx":
+			c.contextLine = style
+		case "fn":
+			c.fileName = style
+		case "ln":
+			c.lineNum = style
+		case "bn":
+			c.byteOffset = style
+		case "se":
+			c.separator = style
+		}
+	}
+	return c
+}
+
+// sprint returns s in style if output is coloured.
+func (g *grepper) sprint(style term.Style, s string) string {
+	return term.Sprint(g.color, style, s)
+}
+
+// grepJob is the search of one file by a worker. Its output is kept
+// until that of the files before it is printed, so that output comes in
+// the order of the files whatever order they are searched in; from then
+// on, it is written as it comes.
+type grepJob struct {
+	name     string // of the file, as printed
+	stdin    bool   // search standard input instead of the file
+	mu       sync.Mutex
+	stdout   jobStream
+	stderr   jobStream
+	items    []any // structured results
+	matched  bool  // whether a line was selected
+	failed   bool  // whether the file could not be searched
+	printed  bool  // whether a line was printed, for group separators
+	leadSep  bool  // a group separator goes before the output if any precedes it
+	preceded bool  // whether output precedes that of the job, once it is live
+	finished chan struct{}
+}
+
+func newGrepJob(name string) *grepJob {
+	j := &grepJob{name: name, finished: make(chan struct{})}
+	j.stdout.mu, j.stderr.mu = &j.mu, &j.mu
+	return j
+}
+
+// jobStream is the standard output or error of a job: a buffer until
+// the job reaches the head of the queue, then the stream itself.
+type jobStream struct {
+	mu  *sync.Mutex // of the job
+	buf bytes.Buffer
+	w   io.Writer // set once the job is live
+}
+
+func (s *jobStream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.w != nil {
+		return s.w.Write(p)
+	}
+	return s.buf.Write(p)
+}
+
+// goLive writes what j has buffered to stdout and stderr, after sep if
+// the output of j starts with a group separator and preceded is set,
+// and makes the rest of its output go straight to them.
+func (j *grepJob) goLive(stdout, stderr io.Writer, preceded bool, sep string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.leadSep && preceded {
+		fmt.Fprintln(stdout, sep)
+	}
+	stdout.Write(j.stdout.buf.Bytes())
+	stderr.Write(j.stderr.buf.Bytes())
+	j.stdout.buf, j.stderr.buf = bytes.Buffer{}, bytes.Buffer{}
+	j.stdout.w, j.stderr.w = stdout, stderr
+	j.preceded = preceded
+}
+
+// separate puts the group separator sep before the first group of
+// lines of j if output of the files before precedes it, which is known
+// only once j is live.
+func (j *grepJob) separate(sep string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	switch {
+	case j.stdout.w == nil:
+		j.leadSep = true
+	case j.preceded:
+		fmt.Fprintln(j.stdout.w, sep)
+	}
+}
+
+// warn reports trouble with a file, unless -s.
+func (j *grepJob) warn(o *grepOptions, format string, args ...any) {
+	if !o.noMessages {
+		fmt.Fprintf(&j.stderr, "grep: "+format+"\n", args...)
+	}
+}
+
+// fail reports an error with a file, unless -s, and makes grep exit
+// with status 2.
+func (j *grepJob) fail(o *grepOptions, format string, args ...any) {
+	j.failed = true
+	j.warn(o, format, args...)
+}
+
+// grepLine is a line of input.
+type grepLine struct {
+	num    int
+	offset int64 // of the start of the line
+	text   string
+	crlf   bool // whether the line ends with "\r\n", which output keeps
+}
+
+// grepReadSize is the size of reads from files. Large reads cut the
+// number of system calls on big files.
+const grepReadSize = 256 * 1024
+
+// grepReaders are reused from file to file, as allocating their
+// buffers for each of many small files costs more than searching them.
+var grepReaders = sync.Pool{New: func() any {
+	return textio.NewReaderSize(nil, grepReadSize)
+}}
+
+// search searches reader for j, writing text or, if g.out is not nil,
+// structured results to it, and reports whether a line was selected.
+func (g *grepper) search(j *grepJob, reader io.Reader, fileName string) bool {
+	ctx, o := g.ctx, g.o
+	tr := grepReaders.Get().(*textio.Reader)
+	defer grepReaders.Put(tr)
+	tr.Reset(reader)
+	defer tr.Reset(nil)
+	// UTF-16 text is matched, and printed, as UTF-8
+	tr.DetectEncoding()
+	// A file is binary if it holds a NUL byte. Only the first read is
+	// checked before searching, so that input trickling in from a pipe,
+	// as from tail -f, is not held up; later lines are checked as they
+	// come.
+	checkBinary := o.binaryFiles != "text"
+	binary := checkBinary && bytes.IndexByte(tr.Buffered(), 0) >= 0
+	if binary && o.binaryFiles == "without-match" {
+		return false
+	}
+
+	// Context lines are printed with text output only.
+	printing := g.out == nil && !o.quiet && !o.countOnly && !o.filesOnly && !binary
+	contextual := printing && !o.onlyMatching && (o.before > 0 || o.after > 0)
+
+	var (
+		lineNum     int
+		offset      int64
+		matchCount  int
+		lastPrinted int        // number of the last line printed, 0 for none
+		afterLeft   int        // trailing context lines still to print
+		before      []grepLine // unprinted lines preceding the current one
+	)
+	for !ctx.Canceled() {
+		text, end, err := tr.ReadLine()
+		if err != nil {
+			if err != io.EOF {
+				j.fail(o, "%s: %v", fileName, pathErr(err))
+			}
+			break
+		}
+		if checkBinary && !binary && bytes.IndexByte(text, 0) >= 0 {
+			// As GNU grep, lines already printed stay; matches from
+			// here on are only reported.
+			if o.binaryFiles == "without-match" {
+				break
+			}
+			binary, contextual = true, false
+		}
+		lineNum++
+		line := grepLine{num: lineNum, offset: offset, text: string(text), crlf: end == "\r\n"}
+		offset += int64(len(text) + len(end))
+
+		// After -m lines, only trailing context is left.
+		limited := o.maxCount >= 0 && matchCount >= o.maxCount
+		if limited && afterLeft == 0 {
+			break
+		}
+		selected := !limited && g.m.match(line.text) != o.invertMatch
+		if !selected {
+			switch {
+			case !contextual:
+			case afterLeft > 0:
+				g.printLine(j, fileName, line, false)
+				lastPrinted = line.num
+				afterLeft--
+			case o.before > 0:
+				if len(before) == o.before {
+					before = before[1:]
+				}
+				before = append(before, line)
+			}
+			continue
+		}
+
+		matchCount++
+		j.matched = true
+		switch {
+		case o.quiet:
+			return true
+		case o.filesOnly:
+			if g.out != nil {
+				j.items = append(j.items, grepFile{File: fileName})
+			} else {
+				fmt.Fprint(&j.stdout, fileName+g.nameEnd("\n"))
+			}
+			return true // Only print filename once
+		case o.countOnly:
+		case binary:
+			if g.out == nil {
+				j.warn(o, "%s: binary file matches", fileName)
+			}
+			return true
+		case g.out != nil:
+			j.items = g.addMatches(j.items, fileName, lineNum, line.text)
+		default:
+			if contextual {
+				first := line.num - len(before)
+				switch {
+				case o.noGroupSep:
+				case lastPrinted == 0:
+					j.separate(g.sprint(g.colors.separator, o.groupSep))
+				case first > lastPrinted+1:
+					fmt.Fprintln(&j.stdout, g.sprint(g.colors.separator, o.groupSep))
+				}
+				for _, b := range before {
+					g.printLine(j, fileName, b, false)
+				}
+				before = before[:0]
+				afterLeft = o.after
+			}
+			if o.onlyMatching {
+				g.printMatches(j, fileName, line)
+			} else {
+				g.printLine(j, fileName, line, true)
+			}
+			lastPrinted = line.num
+		}
+	}
+
+	if o.countOnly && !o.quiet {
+		switch {
+		case g.out != nil:
+			j.items = append(j.items, grepCount{File: fileName, Count: matchCount})
+		case g.showFileName:
+			fmt.Fprintf(&j.stdout, "%s%s%d\n", g.sprint(g.colors.fileName, fileName), g.nameEnd(g.sprint(g.colors.separator, ":")), matchCount)
+		default:
+			fmt.Fprintf(&j.stdout, "%d\n", matchCount)
+		}
+	}
+
+	return matchCount > 0
+}
+
+// nameEnd returns what follows a file name in output: sep, or a NUL
+// byte with -Z.
+func (g *grepper) nameEnd(sep string) string {
+	if g.o.null {
+		return "\x00"
+	}
+	return sep
+}
+
+// prefix returns the file name, line number and byte offset that start
+// an output line, each followed by ":" for selected lines and "-" for
+// context lines.
+func (g *grepper) prefix(fileName string, line grepLine, offset int64, selected bool) string {
+	sep := "-"
+	if selected {
+		sep = ":"
+	}
+	sep = g.sprint(g.colors.separator, sep)
+
+	var b strings.Builder
+	if g.showFileName {
+		b.WriteString(g.sprint(g.colors.fileName, fileName) + g.nameEnd(sep))
+	}
+	if g.o.showLineNum {
+		b.WriteString(g.sprint(g.colors.lineNum, strconv.Itoa(line.num)) + sep)
+	}
+	if g.o.byteOffset {
+		b.WriteString(g.sprint(g.colors.byteOffset, strconv.FormatInt(offset, 10)) + sep)
+	}
+	return b.String()
+}
+
+// printLine prints a selected or context line for j, highlighting
+// matches in selected lines, or in context lines with -v.
+func (g *grepper) printLine(j *grepJob, fileName string, line grepLine, selected bool) {
+	text := line.text
+	if g.color {
+		matchStyle, lineStyle := g.colors.selectedMatch, g.colors.selectedLine
+		if !selected {
+			matchStyle, lineStyle = g.colors.contextMatch, g.colors.contextLine
+		}
+		var b strings.Builder
+		pos := 0
+		if selected != g.o.invertMatch {
+			for _, m := range g.m.find(text) {
+				if m[0] == m[1] {
+					continue
+				}
+				b.WriteString(term.Sprint(true, lineStyle, text[pos:m[0]]))
+				b.WriteString(term.Sprint(true, matchStyle, text[m[0]:m[1]]))
+				pos = m[1]
+			}
+		}
+		b.WriteString(term.Sprint(true, lineStyle, text[pos:]))
+		text = b.String()
+	}
+	end := "\n"
+	if line.crlf {
+		end = "\r\n"
+	}
+	fmt.Fprint(&j.stdout, g.prefix(fileName, line, line.offset, selected)+text+end)
+	j.printed = true
+}
+
+// printMatches prints each nonempty match in a selected line on a line
+// of its own for j, for -o. With -v there are none.
+func (g *grepper) printMatches(j *grepJob, fileName string, line grepLine) {
+	if g.o.invertMatch {
+		return
+	}
+	for _, m := range g.m.find(line.text) {
+		if m[0] == m[1] {
+			continue
+		}
+		match := g.sprint(g.colors.selectedMatch, line.text[m[0]:m[1]])
+		fmt.Fprintln(&j.stdout, g.prefix(fileName, line, line.offset+int64(m[0]), true)+match)
+		j.printed = true
+	}
+}
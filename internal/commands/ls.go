@@ -2,47 +2,230 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
-	"os"
+	"io"
+	"io/fs"
 	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/fsmeta"
+	"github.com/CRTYPUBG/winux/internal/glob"
+	"github.com/CRTYPUBG/winux/internal/lscolors"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/term"
 	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
 )
 
-// Ls implements the ls command.
-// Usage: ls [-l] [-a] [-h] [path...]
-func Ls(args []string) int {
-	// Parse flags
-	showAll := false      // -a: show hidden files
-	longFormat := false   // -l: long listing format
-	humanReadable := false // -h: human readable sizes
-	var paths []string
-
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			// Short flags
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'a':
-					showAll = true
-				case 'l':
-					longFormat = true
-				case 'h':
-					humanReadable = true
-				default:
-					fmt.Fprintf(os.Stderr, "ls: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
+// lsOptions holds the parsed ls flags.
+type lsOptions struct {
+	all           bool     // -a: show hidden files, . and ..
+	almostAll     bool     // -A: show hidden files
+	hide          []string // --hide: patterns of names to hide without -a or -A
+	ignore        []string // -I: patterns of names never to list
+	long          bool     // -l: long listing format
+	humanReadable bool     // -h: human readable sizes
+	recursive     bool     // -R: list subdirectories recursively
+	reverse       bool     // -r: reverse the sort order
+	directory     bool     // -d: list directories themselves
+	classify      bool     // -F: append indicators such as * and @
+	inode         bool     // -i: print file IDs
+	numeric       bool     // -n: print user and group IDs instead of names
+	noOwner       bool     // -g: omit the owner column
+	noGroup       bool     // -o, -G: omit the group column
+	attributes    bool     // --attributes: print Windows attributes
+	groupDirs     bool     // --group-directories-first
+	sort          string   // name, none, time, size, extension or version
+	layout        string   // one, columns or across; empty for the default
+	width         int      // -w: output width, 0 for no limit, -1 for the terminal's
+	timeStyle     string   // --time-style
+	color         term.When
+}
+
+// lsEntry is a file in the structured output of ls.
+type lsEntry struct {
+	Name   string    `json:"name"`
+	Path   string    `json:"path"` // the operand joined with Name
+	Type   string    `json:"type"` // file, dir, symlink or other
+	Size   int64     `json:"size"`
+	Mode   string    `json:"mode"`
+	MTime  time.Time `json:"mtime"`
+	Target string    `json:"target,omitempty"` // of a symbolic link
+}
+
+// lsSortKeys are the arguments of --sort.
+var lsSortKeys = []string{"none", "name", "size", "time", "version", "extension"}
+
+func newLsFlags(o *lsOptions) *flags.FlagSet {
+	fs := flags.New("ls", "[OPTION]... [FILE]...")
+	fs.Description = `List information about the FILEs (the current directory by default).
+Entries are sorted by name, ignoring case, unless an option says otherwise.`
+	setSort := func(key string) func(string) error {
+		return func(string) error {
+			o.sort = key
+			return nil
+		}
+	}
+	setLayout := func(layout string) func(string) error {
+		return func(string) error {
+			o.layout = layout
+			return nil
+		}
+	}
+	fs.Func("a,all", "", "do not ignore hidden entries, and list . and ..", func(string) error {
+		o.all, o.almostAll = true, false
+		return nil
+	})
+	fs.Func("A,almost-all", "", "do not ignore hidden entries, but do not list . and ..", func(string) error {
+		o.all, o.almostAll = false, true
+		return nil
+	})
+	fs.StringList(&o.hide, "hide", "PATTERN", "do not list entries matching shell PATTERN\n(overridden by -a or -A)")
+	fs.StringList(&o.ignore, "I,ignore", "PATTERN", "do not list entries matching shell PATTERN")
+	fs.Bool(&o.long, "l", "use a long listing format")
+	fs.Func("n,numeric-uid-gid", "", "like -l, but list numeric user and group IDs", func(string) error {
+		o.long, o.numeric = true, true
+		return nil
+	})
+	fs.Func("g", "", "like -l, but do not list owner", func(string) error {
+		o.long, o.noOwner = true, true
+		return nil
+	})
+	fs.Func("o", "", "like -l, but do not list group information", func(string) error {
+		o.long, o.noGroup = true, true
+		return nil
+	})
+	fs.Bool(&o.noGroup, "G,no-group", "in a long listing, don't print group names")
+	fs.Bool(&o.attributes, "attributes", "in a long listing, print Windows attributes (darhsl)\nafter the mode")
+	fs.Bool(&o.humanReadable, "h,human-readable", "with -l, print sizes in human readable format")
+	fs.Bool(&o.recursive, "R,recursive", "list subdirectories recursively")
+	fs.Bool(&o.directory, "d,directory", "list directories themselves, not their contents")
+	fs.Bool(&o.classify, "F,classify", "append indicator (one of */=@|) to entries")
+	fs.Bool(&o.inode, "i,inode", "print the index number (file ID) of each file")
+	fs.Func("t", "", "sort by modification time, newest first", setSort("time"))
+	fs.Func("S", "", "sort by file size, largest first", setSort("size"))
+	fs.Func("X", "", "sort alphabetically by entry extension", setSort("extension"))
+	fs.Func("v", "", "natural sort of (version) numbers within text", setSort("version"))
+	fs.Func("U", "", "do not sort; list entries in directory order", setSort("none"))
+	fs.Func("sort", "WORD", "sort by WORD instead of name: none (-U), size (-S),\ntime (-t), version (-v), extension (-X)", func(v string) error {
+		for _, k := range lsSortKeys {
+			if v == k {
+				o.sort = k
+				return nil
 			}
-		} else if arg == "--all" {
-			showAll = true
-		} else if arg == "--help" {
-			printLsHelp()
-			return utils.ExitSuccess
-		} else {
-			paths = append(paths, arg)
+		}
+		return fmt.Errorf("invalid argument '%s'", v)
+	})
+	fs.Bool(&o.reverse, "r,reverse", "reverse order while sorting")
+	fs.Bool(&o.groupDirs, "group-directories-first", "group directories before files")
+	fs.Func("1", "", "list one file per line", setLayout("one"))
+	fs.Func("C", "", "list entries by columns", setLayout("columns"))
+	fs.Func("x", "", "list entries by lines instead of by columns", setLayout("across"))
+	fs.Int(&o.width, "w,width", "COLS", "set output width to COLS; 0 means no limit")
+	fs.Func("color", "WHEN", "colour file names: always, auto or never;\nLS_COLORS chooses the colours", func(v string) error {
+		w, err := term.ParseWhen(v)
+		o.color = w
+		return err
+	}).OptionalArg("always")
+	fs.String(&o.timeStyle, "time-style", "STYLE", "time format for -l: full-iso, long-iso, iso, locale\nor +FORMAT (strftime); TIME_STYLE sets the default")
+	fs.Footer = `Without -1, -C, -x or -l, entries are listed in columns when output is
+a terminal, sized to its width (or COLUMNS), and one per line otherwise.
+Directories are always marked with a trailing /. On Windows, owners
+and groups are account names, or SIDs with -n.
+
+Hidden entries are those with the hidden attribute on Windows and
+those starting with "." elsewhere; set WINUX_HIDDEN to dotfiles,
+attributes or both to choose.
+
+Names are coloured on terminals unless --color=never, ui.color or
+NO_COLOR say otherwise. LS_COLORS uses the format of dircolors, with
+jn for junctions and hi for hidden files as additions; without it,
+directories, links, Windows executables (.exe, .bat, .cmd, .ps1) and
+archives get built-in colours.`
+	fs.Examples = []string{
+		"ls -la",
+		`ls -lh C:\Users`,
+		"ls -lt --time-style=long-iso",
+		"ls -RF src",
+		"ls --color=always | more",
+	}
+	return fs
+}
+
+// LsFlags returns the ls flag set, for help and documentation.
+func LsFlags() *flags.FlagSet {
+	return newLsFlags(&lsOptions{})
+}
+
+// lsFile is a file to be listed.
+type lsFile struct {
+	name string // as shown: the entry name, or the operand
+	path string // for access and headers: the operand joined with name
+	info fs.FileInfo
+}
+
+// lister lists files for one run of ls.
+type lister struct {
+	ctx    *core.Context
+	o      *lsOptions
+	out    *output.List // structured output, or nil for text
+	now    time.Time
+	width  int
+	colors *lscolors.Colors // nil without colour
+	hider  fsmeta.Hider
+
+	// exitCode becomes ExitFailure for trouble with subdirectories
+	// and ExitUsageError for trouble with operands, as in GNU ls.
+	exitCode int
+}
+
+// Ls implements the ls command.
+// Usage: ls [-lngoGaAhRrdFi1Cx] [-t|-S|-X|-v|-U] [path...]
+func Ls(ctx *core.Context, args []string) int {
+	o := lsOptions{sort: "name", width: -1}
+	fs := newLsFlags(&o)
+	paths, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if o.timeStyle == "" {
+		o.timeStyle = ctx.Getenv("TIME_STYLE")
+	}
+	if err := checkTimeStyle(o.timeStyle); err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if o.layout == "" {
+		o.layout = "one"
+		if term.IsTerminal(ctx.Stdout) {
+			o.layout = "columns"
+		}
+	}
+
+	l := &lister{ctx: ctx, o: &o, now: time.Now(), width: o.width, exitCode: utils.ExitSuccess}
+	l.hider, err = fsmeta.ParseHider(ctx.Getenv(fsmeta.HiddenEnv))
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "ls: %v\n", err)
+		l.hider, _ = fsmeta.ParseHider("")
+	}
+	if l.width < 0 {
+		l.width = ctx.Width()
+	}
+	if ctx.Output.Structured() {
+		l.out = output.NewList(ctx.Stdout, ctx.Output)
+		defer l.out.Close()
+	} else if color, restore := ctx.UseColor(ctx.Stdout, o.color); color {
+		defer restore()
+		l.colors, err = lscolors.FromEnv(ctx.Getenv)
+		if err != nil {
+			// As GNU ls, carry on without colour.
+			fmt.Fprintf(ctx.Stderr, "ls: unparsable value for %s environment variable\n", lscolors.Env)
 		}
 	}
 
@@ -51,79 +234,534 @@ func Ls(args []string) int {
 		paths = []string{"."}
 	}
 
-	exitCode := utils.ExitSuccess
-	multiplePaths := len(paths) > 1
-
-	for i, path := range paths {
-		if multiplePaths {
-			if i > 0 {
-				fmt.Println()
-			}
-			fmt.Printf("%s:\n", path)
+	// Files go first as one group, then the contents of each directory.
+	var files, dirs []lsFile
+	for _, path := range paths {
+		info, err := l.statOperand(path)
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "ls: cannot access '%s': %v\n", path, pathErr(err))
+			l.exitCode = utils.ExitUsageError
+			continue
+		}
+		f := lsFile{name: path, path: path, info: info}
+		if info.IsDir() && !o.directory {
+			dirs = append(dirs, f)
+		} else {
+			files = append(files, f)
 		}
+	}
 
-		code := listDir(path, showAll, longFormat, humanReadable)
-		if code != utils.ExitSuccess {
-			exitCode = code
+	l.sort(files)
+	l.print(files)
+	l.sort(dirs)
+	headers := len(paths) > 1 || o.recursive
+	for i, d := range dirs {
+		if ctx.Canceled() {
+			return utils.ExitFailure
+		}
+		if l.out == nil && (i > 0 || len(files) > 0) {
+			fmt.Fprintln(ctx.Stdout)
 		}
+		l.listDir(d, headers, true)
 	}
 
-	return exitCode
+	return l.exitCode
 }
 
-func listDir(path string, showAll, longFormat, humanReadable bool) int {
-	entries, err := os.ReadDir(path)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "ls: cannot access '%s': %v\n", path, err)
-		return utils.ExitUsageError
+// statOperand describes a FILE operand. As in GNU ls, a symbolic link
+// is followed only if it points to a directory and none of -l, -d and
+// -F asks to describe the link itself; a dangling link is still listed.
+func (l *lister) statOperand(path string) (fs.FileInfo, error) {
+	fsys := l.ctx.Files()
+	info, err := fsys.Lstat(l.ctx.Path(path))
+	if err != nil || info.Mode()&fs.ModeSymlink == 0 || l.o.long || l.o.directory || l.o.classify {
+		return info, err
 	}
+	if target, err := fsys.Stat(l.ctx.Path(path)); err == nil && target.IsDir() {
+		return target, nil
+	}
+	return info, nil
+}
 
-	// Sort entries alphabetically (case-insensitive)
-	sort.Slice(entries, func(i, j int) bool {
-		return strings.ToLower(entries[i].Name()) < strings.ToLower(entries[j].Name())
-	})
+// pathErr returns the cause of a *fs.PathError, whose operation and
+// path would only repeat what the message already says.
+func pathErr(err error) error {
+	var pe *fs.PathError
+	if errors.As(err, &pe) {
+		return pe.Err
+	}
+	return err
+}
+
+// listDir lists the contents of the directory d, preceded by its name
+// if header is set, and then its subdirectories with -R.
+func (l *lister) listDir(d lsFile, header, operand bool) {
+	ctx := l.ctx
+	if header && l.out == nil {
+		fmt.Fprintf(ctx.Stdout, "%s:\n", d.path)
+	}
+
+	entries, err := ctx.Files().ReadDir(ctx.Path(d.path))
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "ls: cannot open directory '%s': %v\n", d.path, pathErr(err))
+		if operand {
+			l.exitCode = utils.ExitUsageError
+		} else if l.exitCode == utils.ExitSuccess {
+			l.exitCode = utils.ExitFailure
+		}
+		return
+	}
 
+	var files []lsFile
+	if l.o.all {
+		for _, name := range []string{".", ".."} {
+			path := joinPath(d.path, name)
+			if matchAny(l.o.ignore, name) {
+				continue
+			}
+			if info, err := ctx.Files().Stat(ctx.Path(path)); err == nil {
+				files = append(files, lsFile{name: name, path: path, info: info})
+			}
+		}
+	}
 	for _, entry := range entries {
 		name := entry.Name()
-
-		// Skip hidden files unless -a
-		if !showAll && strings.HasPrefix(name, ".") {
+		if matchAny(l.o.ignore, name) {
 			continue
 		}
 
-		if longFormat {
-			info, err := entry.Info()
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "ls: cannot stat '%s': %v\n", filepath.Join(path, name), err)
+		path := joinPath(d.path, name)
+		info, err := entry.Info()
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "ls: cannot access '%s': %v\n", path, pathErr(err))
+			if l.exitCode == utils.ExitSuccess {
+				l.exitCode = utils.ExitFailure
+			}
+			continue
+		}
+		if !l.o.all && !l.o.almostAll {
+			attrs := ctx.Metadata().Attributes(ctx.Path(path), info)
+			if l.hider.Hidden(name, attrs) || matchAny(l.o.hide, name) {
 				continue
 			}
+		}
+		files = append(files, lsFile{name: name, path: path, info: info})
+	}
+
+	l.sort(files)
+	l.print(files)
+
+	if !l.o.recursive {
+		return
+	}
+	for _, f := range files {
+		if ctx.Canceled() {
+			return
+		}
+		if f.info.IsDir() && f.name != "." && f.name != ".." {
+			if l.out == nil {
+				fmt.Fprintln(ctx.Stdout)
+			}
+			l.listDir(f, true, false)
+		}
+	}
+}
+
+// matchAny reports whether name matches one of the shell patterns. As
+// in GNU ls, a leading "." must be matched explicitly.
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := glob.MatchName(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// joinPath joins a directory as the user wrote it and a name in it,
+// keeping a leading "./" as GNU ls does in recursive headers.
+func joinPath(dir, name string) string {
+	if strings.HasSuffix(dir, "/") || strings.HasSuffix(dir, `\`) {
+		return dir + name
+	}
+	return dir + string(filepath.Separator) + name
+}
+
+// sort orders files by the chosen key, then reverses them for -r and
+// moves directories first for --group-directories-first.
+func (l *lister) sort(files []lsFile) {
+	byName := func(a, b lsFile) int {
+		if c := strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.name, b.name)
+	}
+	var cmp func(a, b lsFile) int
+	switch l.o.sort {
+	case "none":
+		cmp = func(a, b lsFile) int { return 0 }
+	case "time":
+		cmp = func(a, b lsFile) int {
+			if ta, tb := a.info.ModTime(), b.info.ModTime(); !ta.Equal(tb) {
+				if ta.After(tb) {
+					return -1
+				}
+				return 1
+			}
+			return byName(a, b)
+		}
+	case "size":
+		cmp = func(a, b lsFile) int {
+			if sa, sb := a.info.Size(), b.info.Size(); sa != sb {
+				if sa > sb {
+					return -1
+				}
+				return 1
+			}
+			return byName(a, b)
+		}
+	case "extension":
+		cmp = func(a, b lsFile) int {
+			if c := strings.Compare(strings.ToLower(filepath.Ext(a.name)), strings.ToLower(filepath.Ext(b.name))); c != 0 {
+				return c
+			}
+			return byName(a, b)
+		}
+	case "version":
+		cmp = func(a, b lsFile) int {
+			if c := compareVersion(a.name, b.name); c != 0 {
+				return c
+			}
+			return strings.Compare(a.name, b.name)
+		}
+	default:
+		cmp = byName
+	}
 
-			// Format: permissions size date name
-			mode := info.Mode().String()
-			size := info.Size()
-			modTime := info.ModTime().Format("Jan _2 15:04")
+	sort.SliceStable(files, func(i, j int) bool {
+		if l.o.reverse {
+			return cmp(files[j], files[i]) < 0
+		}
+		return cmp(files[i], files[j]) < 0
+	})
+	if l.o.groupDirs {
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].info.IsDir() && !files[j].info.IsDir()
+		})
+	}
+}
 
-			sizeStr := fmt.Sprintf("%8d", size)
-			if humanReadable {
-				sizeStr = fmt.Sprintf("%8s", formatSize(size))
+// compareVersion compares a and b with runs of digits compared as
+// numbers, so that "file2" sorts before "file10".
+func compareVersion(a, b string) int {
+	for a != "" && b != "" {
+		da, db := digitPrefix(a), digitPrefix(b)
+		if da != "" && db != "" {
+			na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+			if len(na) != len(nb) {
+				return len(na) - len(nb)
 			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			a, b = a[len(da):], b[len(db):]
+			continue
+		}
+		if a[0] != b[0] {
+			return int(a[0]) - int(b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+func digitPrefix(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// print writes a group of sorted files in the chosen format.
+func (l *lister) print(files []lsFile) {
+	if len(files) == 0 {
+		return
+	}
+	if l.out != nil {
+		for _, f := range files {
+			l.out.Add(lsEntry{
+				Name:   filepath.Base(f.name),
+				Path:   filepath.Clean(f.path),
+				Type:   fileType(f.info.Mode()),
+				Size:   f.info.Size(),
+				Mode:   modeString(f.info.Mode()),
+				MTime:  f.info.ModTime(),
+				Target: l.linkTarget(f),
+			})
+		}
+		return
+	}
+
+	metas := make([]fsmeta.Meta, len(files))
+	if l.o.long || l.o.inode || l.colors != nil {
+		for i, f := range files {
+			metas[i] = l.ctx.Metadata().Lookup(l.ctx.Path(f.path), f.info)
+		}
+	}
+
+	// File IDs are right-aligned in a column of their own.
+	ids := make([]string, len(files))
+	idWidth := 0
+	if l.o.inode {
+		for i, m := range metas {
+			ids[i] = "?"
+			if m.ID != 0 {
+				ids[i] = strconv.FormatUint(m.ID, 10)
+			}
+			idWidth = max(idWidth, len(ids[i]))
+		}
+	}
+	prefix := func(i int) string {
+		if !l.o.inode {
+			return ""
+		}
+		return fmt.Sprintf("%*s ", idWidth, ids[i])
+	}
+
+	if l.o.long {
+		l.printLong(files, metas, prefix)
+		return
+	}
+
+	cells := make([]string, len(files))
+	for i, f := range files {
+		cells[i] = prefix(i) + l.colorName(f, metas[i]) + l.indicator(f.info)
+	}
+	switch l.o.layout {
+	case "columns":
+		printColumns(l.ctx.Stdout, cells, l.width, false)
+	case "across":
+		printColumns(l.ctx.Stdout, cells, l.width, true)
+	default:
+		for _, c := range cells {
+			fmt.Fprintln(l.ctx.Stdout, c)
+		}
+	}
+}
+
+// Columns of the long format whose width depends on their contents.
+const (
+	colLinks = iota
+	colOwner
+	colGroup
+	colSize
+	numCols
+)
+
+// printLong writes files in the long format: mode, link count, owner,
+// group, size, modification time and name, with the columns aligned
+// across the group as in GNU ls. Each line starts with prefix(i).
+func (l *lister) printLong(files []lsFile, metas []fsmeta.Meta, prefix func(int) string) {
+	cells := make([][numCols]string, len(files))
+	var widths [numCols]int
+	for i, f := range files {
+		m := metas[i]
+		c := &cells[i]
+		c[colLinks] = "?"
+		if m.Links != 0 {
+			c[colLinks] = strconv.FormatUint(m.Links, 10)
+		}
+		c[colOwner] = l.account(m.UID, m.Owner)
+		c[colGroup] = l.account(m.GID, m.Group)
+		c[colSize] = strconv.FormatInt(f.info.Size(), 10)
+		if l.o.humanReadable {
+			c[colSize] = formatSize(f.info.Size())
+		}
+		for col, s := range c {
+			widths[col] = max(widths[col], len(s))
+		}
+	}
 
-			// Directory indicator
-			if entry.IsDir() {
-				name = name + "/"
+	for i, f := range files {
+		c := cells[i]
+		var line strings.Builder
+		line.WriteString(prefix(i))
+		line.WriteString(modeString(f.info.Mode()))
+		if l.o.attributes {
+			kind := "-"
+			if f.info.IsDir() {
+				kind = "d"
 			}
+			line.WriteString(" " + kind + metas[i].Attrs.String())
+		}
+		fmt.Fprintf(&line, " %*s", widths[colLinks], c[colLinks])
+		if !l.o.noOwner {
+			fmt.Fprintf(&line, " %-*s", widths[colOwner], c[colOwner])
+		}
+		if !l.o.noGroup {
+			fmt.Fprintf(&line, " %-*s", widths[colGroup], c[colGroup])
+		}
+		fmt.Fprintf(&line, " %*s %s %s", widths[colSize], c[colSize],
+			formatTime(f.info.ModTime(), l.o.timeStyle, l.now), l.colorName(f, metas[i]))
+		if f.info.Mode()&fs.ModeSymlink != 0 {
+			line.WriteString(" -> " + l.linkTarget(f))
+		} else {
+			line.WriteString(l.indicator(f.info))
+		}
+		line.WriteByte('\n')
+		io.WriteString(l.ctx.Stdout, line.String())
+	}
+}
 
-			fmt.Printf("%s %s %s %s\n", mode, sizeStr, modTime, name)
+// modeString formats mode as GNU ls does: a file type letter (d, l, c,
+// b, p, s or -) and nine permission characters, with s, S, t or T
+// standing for the set-user-ID, set-group-ID and sticky bits.
+func modeString(mode fs.FileMode) string {
+	var b [10]byte
+	switch {
+	case mode.IsDir():
+		b[0] = 'd'
+	case mode&fs.ModeSymlink != 0:
+		b[0] = 'l'
+	case mode&fs.ModeCharDevice != 0:
+		b[0] = 'c'
+	case mode&fs.ModeDevice != 0:
+		b[0] = 'b'
+	case mode&fs.ModeNamedPipe != 0:
+		b[0] = 'p'
+	case mode&fs.ModeSocket != 0:
+		b[0] = 's'
+	case mode&fs.ModeIrregular != 0:
+		b[0] = '?'
+	default:
+		b[0] = '-'
+	}
+	const rwx = "rwxrwxrwx"
+	for i := 0; i < 9; i++ {
+		b[i+1] = '-'
+		if mode&(1<<uint(8-i)) != 0 {
+			b[i+1] = rwx[i]
+		}
+	}
+	special := func(i int, set bool, c byte) {
+		if !set {
+			return
+		}
+		if b[i] == 'x' {
+			b[i] = c
 		} else {
-			if entry.IsDir() {
-				fmt.Printf("%s/\n", name)
-			} else {
-				fmt.Println(name)
+			b[i] = c - 'a' + 'A'
+		}
+	}
+	special(3, mode&fs.ModeSetuid != 0, 's')
+	special(6, mode&fs.ModeSetgid != 0, 's')
+	special(9, mode&fs.ModeSticky != 0, 't')
+	return string(b[:])
+}
+
+// linkTarget returns the destination of f if it is a symbolic link,
+// or "" otherwise or if it cannot be read.
+func (l *lister) linkTarget(f lsFile) string {
+	if f.info.Mode()&fs.ModeSymlink == 0 {
+		return ""
+	}
+	target, err := vfs.Readlink(l.ctx.Files(), l.ctx.Path(f.path))
+	if err != nil {
+		return ""
+	}
+	return target
+}
+
+// account returns the owner or group column: the account name, or the
+// numeric ID with -n or when the name is unknown, or "?".
+func (l *lister) account(id, name string) string {
+	switch {
+	case name != "" && !l.o.numeric:
+		return name
+	case id != "":
+		return id
+	}
+	return "?"
+}
+
+// colorName returns the name of f in its LS_COLORS style.
+func (l *lister) colorName(f lsFile, m fsmeta.Meta) string {
+	if l.colors == nil {
+		return f.name
+	}
+	path := l.ctx.Path(f.path)
+	mode := f.info.Mode()
+	base := filepath.Base(f.name)
+	file := lscolors.File{
+		Name:     base,
+		Mode:     mode,
+		Exec:     mode.IsRegular() && isExecutable(f.info),
+		Hidden:   l.hider.Hidden(base, m.Attrs),
+		Junction: m.Attrs&fsmeta.Junction != 0,
+		Links:    int(m.Links),
+	}
+	if mode&fs.ModeSymlink != 0 {
+		if target, err := l.ctx.Files().Stat(path); err != nil {
+			file.Orphan = true
+		} else {
+			file.Target = &lscolors.File{
+				Name: base,
+				Mode: target.Mode(),
+				Exec: target.Mode().IsRegular() && isExecutable(target),
 			}
 		}
 	}
+	return term.Sprint(true, l.colors.Style(file), f.name)
+}
 
-	return utils.ExitSuccess
+// indicator returns the character that follows the name of a file:
+// "/" for directories and, with -F, "@" for symbolic links, "*" for
+// executables, "|" for pipes and "=" for sockets.
+func (l *lister) indicator(info fs.FileInfo) string {
+	mode := info.Mode()
+	switch {
+	case mode.IsDir():
+		return "/"
+	case !l.o.classify:
+		return ""
+	case mode&fs.ModeSymlink != 0:
+		return "@"
+	case mode&fs.ModeNamedPipe != 0:
+		return "|"
+	case mode&fs.ModeSocket != 0:
+		return "="
+	case mode.IsRegular() && isExecutable(info):
+		return "*"
+	}
+	return ""
+}
+
+// isExecutable reports whether a regular file can be run: by its
+// extension on Windows and by its mode elsewhere.
+func isExecutable(info fs.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(info.Name())) {
+		case ".exe", ".com", ".bat", ".cmd", ".ps1":
+			return true
+		}
+		return false
+	}
+	return info.Mode()&0111 != 0
+}
+
+// fileType names the type of a file for structured output.
+func fileType(mode fs.FileMode) string {
+	switch {
+	case mode.IsDir():
+		return "dir"
+	case mode&fs.ModeSymlink != 0:
+		return "symlink"
+	case mode.IsRegular():
+		return "file"
+	default:
+		return "other"
+	}
 }
 
 func formatSize(bytes int64) string {
@@ -144,15 +782,3 @@ func formatSize(bytes int64) string {
 		return fmt.Sprintf("%dB", bytes)
 	}
 }
-
-func printLsHelp() {
-	fmt.Println(`Usage: ls [OPTION]... [FILE]...
-
-List directory contents.
-
-Options:
-  -a, --all    do not ignore entries starting with .
-  -l           use a long listing format
-  -h           with -l, print sizes in human readable format
-  --help       display this help and exit`)
-}
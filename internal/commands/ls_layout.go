@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/CRTYPUBG/winux/internal/term"
+)
+
+// columnGap separates columns in -C and -x output.
+const columnGap = 2
+
+// printColumns writes cells in as many columns as fit in width, going
+// down the columns first, or across the rows if across is set. A width
+// of 0 puts everything on one line. Colour escapes in cells take no
+// room.
+func printColumns(w io.Writer, cells []string, width int, across bool) {
+	n := len(cells)
+	lens := make([]int, n)
+	for i, c := range cells {
+		lens[i] = term.StringWidth(term.Strip(c))
+	}
+
+	// Try the most columns first; one column always fits. Cells are at
+	// least one character wide, which bounds the columns that can fit.
+	maxCols := n
+	if width > 0 {
+		maxCols = min(n, max(1, width/(1+columnGap)))
+	}
+	var rows int
+	var widths []int
+	for cols := maxCols; cols >= 1; cols-- {
+		rows = (n + cols - 1) / cols
+		if !across && (n+rows-1)/rows != cols {
+			// Going down, the last columns would be empty.
+			continue
+		}
+		widths = make([]int, cols)
+		total := columnGap * (cols - 1)
+		for i, l := range lens {
+			c := i / rows
+			if across {
+				c = i % cols
+			}
+			widths[c] = max(widths[c], l)
+		}
+		for _, cw := range widths {
+			total += cw
+		}
+		if width == 0 || total < width {
+			break
+		}
+	}
+
+	cols := len(widths)
+	for r := 0; r < rows; r++ {
+		var line strings.Builder
+		for c := 0; c < cols; c++ {
+			i := c*rows + r
+			if across {
+				i = r*cols + c
+			}
+			if i >= n {
+				break
+			}
+			line.WriteString(cells[i])
+			last := c == cols-1 || (across && i == n-1) || (!across && (c+1)*rows+r >= n)
+			if !last {
+				line.WriteString(strings.Repeat(" ", widths[c]-lens[i]+columnGap))
+			}
+		}
+		fmt.Fprintln(w, line.String())
+	}
+}
+
+// Time styles of --time-style; a style starting with "+" is a strftime
+// format instead.
+var timeStyles = map[string]bool{
+	"": true, "locale": true, "full-iso": true, "long-iso": true, "iso": true,
+}
+
+// checkTimeStyle reports an unknown --time-style.
+func checkTimeStyle(style string) error {
+	style = strings.TrimPrefix(style, "posix-")
+	if timeStyles[style] || strings.HasPrefix(style, "+") {
+		return nil
+	}
+	return fmt.Errorf("invalid argument '%s' for '--time-style'\nValid arguments are: full-iso, long-iso, iso, locale, +FORMAT", style)
+}
+
+// formatTime formats t for the -l listing in style. As in GNU ls, the
+// default, locale and iso styles show the time of day for recent times
+// and the year instead for times more than six months before now or in
+// the future. "+FORMAT1\nFORMAT2" uses FORMAT1 for such times and
+// FORMAT2 for recent ones.
+func formatTime(t time.Time, style string, now time.Time) string {
+	style = strings.TrimPrefix(style, "posix-")
+	recent := t.After(now.AddDate(0, -6, 0)) && !t.After(now)
+	switch {
+	case style == "full-iso":
+		return t.Format("2006-01-02 15:04:05.000000000 -0700")
+	case style == "long-iso":
+		return t.Format("2006-01-02 15:04")
+	case style == "iso" && recent:
+		return t.Format("01-02 15:04")
+	case style == "iso":
+		return t.Format("2006-01-02 ")
+	case strings.HasPrefix(style, "+"):
+		format := style[1:]
+		if old, new, ok := strings.Cut(format, "\n"); ok {
+			format = old
+			if recent {
+				format = new
+			}
+		}
+		return strftime(t, format)
+	case recent:
+		return t.Format("Jan _2 15:04")
+	}
+	return t.Format("Jan _2  2006")
+}
+
+// strftime formats t like the C function of the same name, supporting
+// the common conversions.
+func strftime(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'c':
+			b.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'D':
+			b.WriteString(t.Format("01/02/06"))
+		case 'e':
+			b.WriteString(t.Format("_2"))
+		case 'F':
+			b.WriteString(t.Format("2006-01-02"))
+		case 'H':
+			b.WriteString(t.Format("15"))
+		case 'I':
+			b.WriteString(t.Format("03"))
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 'k':
+			fmt.Fprintf(&b, "%2d", t.Hour())
+		case 'l':
+			b.WriteString(t.Format("_3"))
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'M':
+			b.WriteString(t.Format("04"))
+		case 'n':
+			b.WriteByte('\n')
+		case 'N':
+			fmt.Fprintf(&b, "%09d", t.Nanosecond())
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'R':
+			b.WriteString(t.Format("15:04"))
+		case 's':
+			fmt.Fprintf(&b, "%d", t.Unix())
+		case 'S':
+			b.WriteString(t.Format("05"))
+		case 't':
+			b.WriteByte('\t')
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case 'y':
+			b.WriteString(t.Format("06"))
+		case 'Y':
+			b.WriteString(t.Format("2006"))
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
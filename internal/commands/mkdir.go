@@ -1,86 +1,74 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strings"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
-// Mkdir implements the mkdir command.
-// Usage: mkdir [-p] [-v] directory...
-func Mkdir(args []string) int {
-	// Parse flags
-	parents := false // -p: create parent directories
-	verbose := false // -v: verbose
+// mkdirOptions holds the parsed mkdir flags.
+type mkdirOptions struct {
+	parents bool // -p: create parent directories
+	verbose bool // -v: verbose
+}
 
-	var dirs []string
+func newMkdirFlags(o *mkdirOptions) *flags.FlagSet {
+	fs := flags.New("mkdir", "[OPTION]... DIRECTORY...")
+	fs.Description = "Create the DIRECTORY(ies), if they do not already exist."
+	fs.Bool(&o.parents, "p,parents", "no error if existing, make parent directories as needed")
+	fs.Bool(&o.verbose, "v,verbose", "print a message for each created directory")
+	fs.Examples = []string{
+		"mkdir newdir",
+		"mkdir -p path/to/newdir",
+		"mkdir -v dir1 dir2 dir3",
+	}
+	return fs
+}
 
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'p':
-					parents = true
-				case 'v':
-					verbose = true
-				default:
-					fmt.Fprintf(os.Stderr, "mkdir: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
-			}
-		} else if arg == "--parents" {
-			parents = true
-		} else if arg == "--verbose" {
-			verbose = true
-		} else if arg == "--help" {
-			printMkdirHelp()
-			return utils.ExitSuccess
-		} else {
-			dirs = append(dirs, arg)
-		}
+// MkdirFlags returns the mkdir flag set, for help and documentation.
+func MkdirFlags() *flags.FlagSet {
+	return newMkdirFlags(&mkdirOptions{})
+}
+
+// Mkdir implements the mkdir command.
+// Usage: mkdir [-p] [-v] directory...
+func Mkdir(ctx *core.Context, args []string) int {
+	var o mkdirOptions
+	fs := newMkdirFlags(&o)
+	dirs, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	if len(dirs) == 0 {
-		fmt.Fprintln(os.Stderr, "mkdir: missing operand")
-		return utils.ExitUsageError
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing operand"))
 	}
 
 	exitCode := utils.ExitSuccess
 
 	for _, dir := range dirs {
+		if ctx.Canceled() {
+			return utils.ExitFailure
+		}
+
 		var err error
 
-		if parents {
-			err = os.MkdirAll(dir, 0755)
+		if o.parents {
+			err = ctx.Files().MkdirAll(ctx.Path(dir), 0755)
 		} else {
-			err = os.Mkdir(dir, 0755)
+			err = ctx.Files().Mkdir(ctx.Path(dir), 0755)
 		}
 
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "mkdir: cannot create directory '%s': %v\n", dir, err)
+			fmt.Fprintf(ctx.Stderr, "mkdir: cannot create directory '%s': %v\n", dir, pathErr(err))
 			exitCode = utils.ExitFailure
-		} else if verbose {
-			fmt.Printf("mkdir: created directory '%s'\n", dir)
+		} else if o.verbose {
+			fmt.Fprintf(ctx.Stdout, "mkdir: created directory '%s'\n", dir)
 		}
 	}
 
 	return exitCode
 }
-
-func printMkdirHelp() {
-	fmt.Println(`Usage: mkdir [OPTION]... DIRECTORY...
-
-Create the DIRECTORY(ies), if they do not already exist.
-
-Options:
-  -p, --parents    no error if existing, make parent directories as needed
-  -v, --verbose    print a message for each created directory
-  --help           display this help and exit
-
-Examples:
-  mkdir newdir
-  mkdir -p path/to/newdir
-  mkdir -v dir1 dir2 dir3`)
-}
@@ -3,43 +3,14 @@ package commands
 import (
 	"bufio"
 	"fmt"
-	"os"
+	"io"
 	"strings"
-	"syscall"
-	"unsafe"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/term"
 	"github.com/CRTYPUBG/winux/internal/utils"
-)
-
-var (
-	kernel32                      = syscall.NewLazyDLL("kernel32.dll")
-	procGetConsoleMode            = kernel32.NewProc("GetConsoleMode")
-	procSetConsoleMode            = kernel32.NewProc("SetConsoleMode")
-	procGetConsoleScreenBufferInfo = kernel32.NewProc("GetConsoleScreenBufferInfo")
-)
-
-type consoleScreenBufferInfo struct {
-	Size              coord
-	CursorPosition    coord
-	Attributes        uint16
-	Window            smallRect
-	MaximumWindowSize coord
-}
-
-type coord struct {
-	X, Y int16
-}
-
-type smallRect struct {
-	Left, Top, Right, Bottom int16
-}
-
-const (
-	enableLineInput       = 0x0002
-	enableEchoInput       = 0x0004
-	enableProcessedInput  = 0x0001
-	enableExtendedFlags   = 0x0080
-	enableVirtualTerminal = 0x0004 // Enable ANSI escape sequences on output
+	"github.com/CRTYPUBG/winux/internal/vfs"
 )
 
 type Editor struct {
@@ -51,35 +22,46 @@ type Editor struct {
 	width       int
 	height      int
 	filename    string
+	path        string // filename resolved against the working directory
+	files       vfs.FS
+	in          io.Reader
+	out         io.Writer
 	dirty       bool
 	statusMsg   string
 	originalIn  uint32
-	originalOut uint32
 }
 
-func Nano(args []string) int {
-	if len(args) < 1 {
-		fmt.Println("Usage: nano <filename>")
-		return utils.ExitUsageError
+func Nano(ctx *core.Context, args []string) int {
+	fs := newNanoFlags()
+	files, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
-
-	for _, arg := range args {
-		if arg == "--help" || arg == "-h" {
-			printNanoHelp()
-			return utils.ExitSuccess
-		}
+	if len(files) < 1 {
+		fmt.Fprintln(ctx.Stderr, "Usage: nano <filename>")
+		return utils.ExitUsageError
 	}
 
-	filename := args[0]
-	e := &Editor{filename: filename}
+	filename := files[0]
+	e := &Editor{filename: filename, path: ctx.Path(filename), files: ctx.Files(), in: ctx.Stdin, out: ctx.Stdout}
 	e.load()
 
 	if err := e.enterRawMode(); err != nil {
-		fmt.Fprintf(os.Stderr, "nano: failed to enter raw mode: %v\n", err)
+		fmt.Fprintf(ctx.Stderr, "nano: failed to enter raw mode: %v\n", err)
 		return utils.ExitFailure
 	}
 	defer e.exitRawMode()
 
+	restoreVT, err := term.EnableVT(e.out)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "nano: terminal does not support escape sequences: %v\n", err)
+		return utils.ExitFailure
+	}
+	defer restoreVT()
+
+	fmt.Fprint(e.out, term.AltScreenEnter)
+	defer fmt.Fprint(e.out, term.AltScreenLeave)
+
 	// Main loop
 	for {
 		e.updateSize()
@@ -93,7 +75,7 @@ func Nano(args []string) int {
 }
 
 func (e *Editor) load() {
-	data, err := os.ReadFile(e.filename)
+	data, err := vfs.ReadFile(e.files, e.path)
 	if err != nil {
 		e.lines = []string{""}
 		e.statusMsg = "New File: " + e.filename
@@ -109,7 +91,7 @@ func (e *Editor) load() {
 
 func (e *Editor) save() {
 	content := strings.Join(e.lines, "\n")
-	err := os.WriteFile(e.filename, []byte(content), 0644)
+	err := vfs.WriteFile(e.files, e.path, []byte(content), 0644)
 	if err != nil {
 		e.statusMsg = "Error saving: " + err.Error()
 	} else {
@@ -118,44 +100,19 @@ func (e *Editor) save() {
 	}
 }
 
-func (e *Editor) enterRawMode() error {
-	in := syscall.Handle(os.Stdin.Fd())
-	out := syscall.Handle(os.Stdout.Fd())
-
-	procGetConsoleMode.Call(uintptr(in), uintptr(unsafe.Pointer(&e.originalIn)))
-	procGetConsoleMode.Call(uintptr(out), uintptr(unsafe.Pointer(&e.originalOut)))
-
-	// Disable echo and line input, enable ANSI processing
-	newIn := e.originalIn &^ (enableLineInput | enableEchoInput | enableProcessedInput)
-	procSetConsoleMode.Call(uintptr(in), uintptr(newIn))
-
-	newOut := e.originalOut | enableVirtualTerminal
-	procSetConsoleMode.Call(uintptr(out), uintptr(newOut))
-
-	// Clear screen and enter alternative buffer (simulated)
-	fmt.Print("\033[?1049h") // Alternate buffer
-	return nil
-}
-
-func (e *Editor) exitRawMode() {
-	fmt.Print("\033[?1049l") // Main buffer
-	in := syscall.Handle(os.Stdin.Fd())
-	out := syscall.Handle(os.Stdout.Fd())
-	procSetConsoleMode.Call(uintptr(in), uintptr(e.originalIn))
-	procSetConsoleMode.Call(uintptr(out), uintptr(e.originalOut))
-}
-
+// updateSize reads the size of the terminal, assuming 80x24 if it is
+// unknown.
 func (e *Editor) updateSize() {
-	var info consoleScreenBufferInfo
-	out := syscall.Handle(os.Stdout.Fd())
-	procGetConsoleScreenBufferInfo.Call(uintptr(out), uintptr(unsafe.Pointer(&info)))
-	e.width = int(info.Window.Right - info.Window.Left + 1)
-	e.height = int(info.Window.Bottom - info.Window.Top + 1)
+	w, h, err := term.Size(e.out)
+	if err != nil {
+		w, h = 80, 24
+	}
+	e.width, e.height = w, h
 }
 
 func (e *Editor) refreshScreen() {
 	var sb strings.Builder
-	sb.WriteString("\033[H") // Move cursor to top-left
+	sb.WriteString(term.CursorHome)
 
 	// 1. Header
 	header := fmt.Sprintf(" WINUX nano %s ", e.filename)
@@ -166,10 +123,8 @@ func (e *Editor) refreshScreen() {
 	if padding < 0 {
 		padding = 0
 	}
-	sb.WriteString("\033[7m") // Invert
-	sb.WriteString(header)
-	sb.WriteString(strings.Repeat(" ", padding))
-	sb.WriteString("\033[0m\r\n")
+	sb.WriteString(term.Sprint(true, term.Reverse, header+strings.Repeat(" ", padding)))
+	sb.WriteString("\r\n")
 
 	// 2. Content
 	viewHeight := e.height - 4 // Header(1) + Status(1) + Help(2)
@@ -187,31 +142,29 @@ func (e *Editor) refreshScreen() {
 			}
 			sb.WriteString(line)
 		}
-		sb.WriteString("\033[K") // Clear to end of line
+		sb.WriteString(term.ClearLine)
 		sb.WriteString("\r\n")
 	}
 
 	// 3. Status Bar
-	sb.WriteString("\033[7m")
 	status := fmt.Sprintf(" Line: %d/%d Col: %d ", e.cursorY+1, len(e.lines), e.cursorX+1)
-	sb.WriteString(status)
-	sb.WriteString(strings.Repeat(" ", e.width-len(status)))
-	sb.WriteString("\033[0m\r\n")
+	sb.WriteString(term.Sprint(true, term.Reverse, status+strings.Repeat(" ", max(e.width-len(status), 0))))
+	sb.WriteString("\r\n")
 
 	// 4. Help Message
-	sb.WriteString("\033[K") // Clear line
+	sb.WriteString(term.ClearLine)
 	sb.WriteString(e.statusMsg)
 	sb.WriteString("\r\n")
-	sb.WriteString("\033[7m^X\033[0m Exit  \033[7m^O\033[0m Save")
+	sb.WriteString(term.Sprint(true, term.Reverse, "^X") + " Exit  " + term.Sprint(true, term.Reverse, "^O") + " Save")
 
 	// 5. Position Cursor
-	sb.WriteString(fmt.Sprintf("\033[%d;%dH", e.cursorY-e.offsetY+2, e.cursorX-e.offsetX+1))
+	sb.WriteString(term.MoveTo(e.cursorY-e.offsetY+2, e.cursorX-e.offsetX+1))
 
-	fmt.Print(sb.String())
+	fmt.Fprint(e.out, sb.String())
 }
 
 func (e *Editor) processInput() bool {
-	reader := bufio.NewReader(os.Stdin)
+	reader := bufio.NewReader(e.in)
 	b, err := reader.ReadByte()
 	if err != nil {
 		return false
@@ -310,15 +263,18 @@ func (e *Editor) processInput() bool {
 	return true
 }
 
-func printNanoHelp() {
-	fmt.Println(`Usage: nano [FILE]
-A minimal terminal text editor for Windows.
-
-Options:
-  --help     display this help and exit
-
-Keybindings:
+func newNanoFlags() *flags.FlagSet {
+	fs := flags.New("nano", "[FILE]")
+	fs.Description = "A minimal terminal text editor for Windows."
+	fs.Help("h,help")
+	fs.Footer = `Keybindings:
   ^X         Exit
   ^O         Save
-  Arrows     Navigate`)
+  Arrows     Navigate`
+	return fs
+}
+
+// NanoFlags returns the nano flag set, for help and documentation.
+func NanoFlags() *flags.FlagSet {
+	return newNanoFlags()
 }
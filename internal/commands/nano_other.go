@@ -0,0 +1,14 @@
+//go:build !windows
+
+package commands
+
+import "errors"
+
+// The editor reads keys from the Windows console; elsewhere it cannot
+// start.
+
+func (e *Editor) enterRawMode() error {
+	return errors.New("console raw mode is only supported on Windows")
+}
+
+func (e *Editor) exitRawMode() {}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32           = syscall.NewLazyDLL("kernel32.dll")
+	procGetConsoleMode = kernel32.NewProc("GetConsoleMode")
+	procSetConsoleMode = kernel32.NewProc("SetConsoleMode")
+)
+
+const (
+	enableLineInput      = 0x0002
+	enableEchoInput      = 0x0004
+	enableProcessedInput = 0x0001
+	enableExtendedFlags  = 0x0080
+)
+
+func (e *Editor) enterRawMode() error {
+	in := syscall.Handle(os.Stdin.Fd())
+	procGetConsoleMode.Call(uintptr(in), uintptr(unsafe.Pointer(&e.originalIn)))
+
+	// Disable echo and line input
+	newIn := e.originalIn &^ (enableLineInput | enableEchoInput | enableProcessedInput)
+	procSetConsoleMode.Call(uintptr(in), uintptr(newIn))
+	return nil
+}
+
+func (e *Editor) exitRawMode() {
+	in := syscall.Handle(os.Stdin.Fd())
+	procSetConsoleMode.Call(uintptr(in), uintptr(e.originalIn))
+}
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// writeResult writes the single result v of command name in the
+// context's structured output format and returns the exit code.
+func writeResult(ctx *core.Context, name string, v any) int {
+	if err := output.Write(ctx.Stdout, ctx.Output, v); err != nil {
+		fmt.Fprintf(ctx.Stderr, "%s: %v\n", name, err)
+		return utils.ExitFailure
+	}
+	return utils.ExitSuccess
+}
@@ -4,35 +4,49 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
+// pwdResult is the structured output of pwd.
+type pwdResult struct {
+	Path string `json:"path"`
+}
+
+func newPwdFlags() *flags.FlagSet {
+	fs := flags.New("pwd", "[OPTION]...")
+	fs.Description = "Print the full filename of the current working directory."
+	fs.Help("h,help")
+	return fs
+}
+
+// PwdFlags returns the pwd flag set, for help and documentation.
+func PwdFlags() *flags.FlagSet {
+	return newPwdFlags()
+}
+
 // Pwd implements the pwd command.
 // Usage: pwd
-func Pwd(args []string) int {
-	// Check for help
-	for _, arg := range args {
-		if arg == "--help" || arg == "-h" {
-			printPwdHelp()
-			return utils.ExitSuccess
-		}
+func Pwd(ctx *core.Context, args []string) int {
+	fs := newPwdFlags()
+	if _, err := fs.Parse(args); err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
-	dir, err := os.Getwd()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "pwd: %v\n", err)
-		return utils.ExitFailure
+	dir := ctx.Dir
+	if dir == "" {
+		var err error
+		dir, err = os.Getwd()
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "pwd: %v\n", err)
+			return utils.ExitFailure
+		}
 	}
 
-	fmt.Println(dir)
+	if ctx.Output.Structured() {
+		return writeResult(ctx, "pwd", pwdResult{Path: dir})
+	}
+	fmt.Fprintln(ctx.Stdout, dir)
 	return utils.ExitSuccess
 }
-
-func printPwdHelp() {
-	fmt.Println(`Usage: pwd
-
-Print the full filename of the current working directory.
-
-Options:
-  --help    display this help and exit`)
-}
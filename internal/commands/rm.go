@@ -1,56 +1,54 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strings"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
-// Rm implements the rm command.
-// Usage: rm [-r] [-f] [-v] file...
-func Rm(args []string) int {
-	// Parse flags
-	recursive := false // -r: recursive
-	force := false     // -f: force (ignore errors)
-	verbose := false   // -v: verbose
+// rmOptions holds the parsed rm flags.
+type rmOptions struct {
+	recursive bool // -r: recursive
+	force     bool // -f: force (ignore errors)
+	verbose   bool // -v: verbose
+}
+
+func newRmFlags(o *rmOptions) *flags.FlagSet {
+	fs := flags.New("rm", "[OPTION]... FILE...")
+	fs.Description = "Remove (unlink) the FILE(s)."
+	fs.Bool(&o.force, "f,force", "ignore nonexistent files, never prompt")
+	fs.Bool(&o.recursive, "r,R,recursive", "remove directories and their contents recursively")
+	fs.Bool(&o.verbose, "v,verbose", "explain what is being done")
+	fs.Examples = []string{
+		"rm file.txt",
+		"rm -f file.txt",
+		"rm -rf directory/",
+		"rm -v file1.txt file2.txt",
+	}
+	return fs
+}
 
-	var files []string
+// RmFlags returns the rm flag set, for help and documentation.
+func RmFlags() *flags.FlagSet {
+	return newRmFlags(&rmOptions{})
+}
 
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'r', 'R':
-					recursive = true
-				case 'f':
-					force = true
-				case 'v':
-					verbose = true
-				default:
-					fmt.Fprintf(os.Stderr, "rm: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
-			}
-		} else if arg == "--recursive" {
-			recursive = true
-		} else if arg == "--force" {
-			force = true
-		} else if arg == "--verbose" {
-			verbose = true
-		} else if arg == "--help" {
-			printRmHelp()
-			return utils.ExitSuccess
-		} else {
-			files = append(files, arg)
-		}
+// Rm implements the rm command.
+// Usage: rm [-r] [-f] [-v] file...
+func Rm(ctx *core.Context, args []string) int {
+	var o rmOptions
+	fs := newRmFlags(&o)
+	files, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	if len(files) == 0 {
-		if !force {
-			fmt.Fprintln(os.Stderr, "rm: missing operand")
-			return utils.ExitUsageError
+		if !o.force {
+			return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing operand"))
 		}
 		return utils.ExitSuccess
 	}
@@ -58,54 +56,40 @@ func Rm(args []string) int {
 	exitCode := utils.ExitSuccess
 
 	for _, file := range files {
-		info, err := os.Lstat(file)
+		if ctx.Canceled() {
+			return utils.ExitFailure
+		}
+
+		info, err := ctx.Files().Lstat(ctx.Path(file))
 		if err != nil {
-			if !force {
-				fmt.Fprintf(os.Stderr, "rm: cannot remove '%s': %v\n", file, err)
+			if !o.force {
+				fmt.Fprintf(ctx.Stderr, "rm: cannot remove '%s': %v\n", file, pathErr(err))
 				exitCode = utils.ExitFailure
 			}
 			continue
 		}
 
 		if info.IsDir() {
-			if !recursive {
-				fmt.Fprintf(os.Stderr, "rm: cannot remove '%s': Is a directory\n", file)
+			if !o.recursive {
+				fmt.Fprintf(ctx.Stderr, "rm: cannot remove '%s': Is a directory\n", file)
 				exitCode = utils.ExitFailure
 				continue
 			}
 
-			err = os.RemoveAll(file)
+			err = ctx.Files().RemoveAll(ctx.Path(file))
 		} else {
-			err = os.Remove(file)
+			err = ctx.Files().Remove(ctx.Path(file))
 		}
 
 		if err != nil {
-			if !force {
-				fmt.Fprintf(os.Stderr, "rm: cannot remove '%s': %v\n", file, err)
+			if !o.force {
+				fmt.Fprintf(ctx.Stderr, "rm: cannot remove '%s': %v\n", file, pathErr(err))
 				exitCode = utils.ExitFailure
 			}
-		} else if verbose {
-			fmt.Printf("removed '%s'\n", file)
+		} else if o.verbose {
+			fmt.Fprintf(ctx.Stdout, "removed '%s'\n", file)
 		}
 	}
 
 	return exitCode
 }
-
-func printRmHelp() {
-	fmt.Println(`Usage: rm [OPTION]... FILE...
-
-Remove (unlink) the FILE(s).
-
-Options:
-  -f, --force      ignore nonexistent files, never prompt
-  -r, -R, --recursive   remove directories and their contents recursively
-  -v, --verbose    explain what is being done
-  --help           display this help and exit
-
-Examples:
-  rm file.txt
-  rm -f file.txt
-  rm -rf directory/
-  rm -v file1.txt file2.txt`)
-}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/shell"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// shOptions holds the parsed sh flags.
+type shOptions struct {
+	command     bool     // -c: run the first operand as a command
+	stdin       bool     // -s: read commands from standard input
+	interactive bool     // -i: force interactive mode
+	set         []string // shell options such as "e" or "pipefail"
+}
+
+func newShFlags(o *shOptions) *flags.FlagSet {
+	fs := flags.New("sh", `[OPTION]... [SCRIPT [ARG]...]
+  or:  sh [OPTION]... -c COMMAND [NAME [ARG]...]`)
+	fs.Description = `Run a POSIX-style shell. winux commands run in-process; other
+programs are found on PATH. Without a script or -c, commands are
+read from standard input, interactively if it is a terminal.
+
+Supported: variables and export, quoting, globbing, $(...) and
+$((...)), pipes and redirections, && || ; &, if/for/while/until/case,
+functions, alias, source, cd, history, jobs and wait.`
+	fs.StopAtOperand = true
+	setOpt := func(name string) func(string) error {
+		return func(string) error {
+			o.set = append(o.set, name)
+			return nil
+		}
+	}
+	fs.Bool(&o.command, "c", "run COMMAND and exit")
+	fs.Bool(&o.stdin, "s", "read commands from standard input")
+	fs.Bool(&o.interactive, "i", "force interactive mode")
+	fs.Func("e", "", "exit when a command fails", setOpt("e"))
+	fs.Func("u", "", "treat unset variables as an error", setOpt("u"))
+	fs.Func("f", "", "disable globbing", setOpt("f"))
+	fs.Func("x", "", "print commands before running them", setOpt("x"))
+	fs.StringList(&o.set, "o", "OPTION", "set a long option; pipefail makes a pipeline fail if any stage fails")
+	fs.Examples = []string{
+		"winux sh build.sh --release",
+		`winux sh -c 'for f in *.log; do grep -c ERROR "$f"; done'`,
+		"winux sh -ec 'cd src && ls'",
+	}
+	return fs
+}
+
+// ShFlags returns the sh flag set, for help and documentation.
+func ShFlags() *flags.FlagSet {
+	return newShFlags(&shOptions{})
+}
+
+// Sh implements the sh command.
+// Usage: sh [-eufx] [-c command [name [arg...]] | -s [arg...] | script [arg...]]
+func Sh(ctx *core.Context, args []string) int {
+	var o shOptions
+	fs := newShFlags(&o)
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+
+	sh := shell.New(ctx)
+	for _, name := range o.set {
+		if !sh.SetOption(name, true) {
+			return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("%s: invalid option name", name))
+		}
+	}
+
+	switch {
+	case o.command:
+		if len(args) == 0 {
+			return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("-c: option requires an argument"))
+		}
+		command := args[0]
+		args = args[1:]
+		name := "sh"
+		if len(args) > 0 {
+			name, args = args[0], args[1:]
+		}
+		sh.SetArgs(name, args)
+		return sh.Run(command)
+
+	case !o.stdin && !o.interactive && len(args) > 0:
+		sh.SetArgs(args[0], args[1:])
+		return sh.RunFile(args[0])
+	}
+
+	sh.SetArgs("sh", args)
+	if o.interactive || !ctx.StdinPiped() {
+		return sh.Interactive()
+	}
+
+	// Script on stdin
+	data, err := io.ReadAll(ctx.Stdin)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "sh: %v\n", err)
+		return utils.ExitFailure
+	}
+	return sh.Run(string(data))
+}
@@ -0,0 +1 @@
+var a=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};=function(b){return b+1};needle();x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;x=1;
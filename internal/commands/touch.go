@@ -1,72 +1,81 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strings"
 	"time"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
 )
 
-// Touch implements the touch command.
-// Usage: touch [-c] file...
-func Touch(args []string) int {
-	// Parse flags
-	noCreate := false // -c: do not create new files
+// touchOptions holds the parsed touch flags.
+type touchOptions struct {
+	noCreate bool // -c: do not create new files
+}
+
+func newTouchFlags(o *touchOptions) *flags.FlagSet {
+	fs := flags.New("touch", "[OPTION]... FILE...")
+	fs.Description = `Update the access and modification times of each FILE to the current time.
+A FILE argument that does not exist is created empty.`
+	fs.Bool(&o.noCreate, "c,no-create", "do not create any files")
+	fs.Examples = []string{
+		"touch file.txt",
+		"touch -c existing.txt",
+		"touch file1.txt file2.txt",
+	}
+	return fs
+}
 
-	var files []string
+// TouchFlags returns the touch flag set, for help and documentation.
+func TouchFlags() *flags.FlagSet {
+	return newTouchFlags(&touchOptions{})
+}
 
-	for _, arg := range args {
-		if strings.HasPrefix(arg, "-") && len(arg) > 1 && arg[1] != '-' {
-			for _, ch := range arg[1:] {
-				switch ch {
-				case 'c':
-					noCreate = true
-				default:
-					fmt.Fprintf(os.Stderr, "touch: invalid option -- '%c'\n", ch)
-					return utils.ExitUsageError
-				}
-			}
-		} else if arg == "--no-create" {
-			noCreate = true
-		} else if arg == "--help" {
-			printTouchHelp()
-			return utils.ExitSuccess
-		} else {
-			files = append(files, arg)
-		}
+// Touch implements the touch command.
+// Usage: touch [-c] file...
+func Touch(ctx *core.Context, args []string) int {
+	var o touchOptions
+	fs := newTouchFlags(&o)
+	files, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	if len(files) == 0 {
-		fmt.Fprintln(os.Stderr, "touch: missing file operand")
-		return utils.ExitUsageError
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing file operand"))
 	}
 
 	exitCode := utils.ExitSuccess
 	now := time.Now()
 
 	for _, file := range files {
-		_, err := os.Stat(file)
+		if ctx.Canceled() {
+			return utils.ExitFailure
+		}
+
+		_, err := ctx.Files().Stat(ctx.Path(file))
 		fileExists := err == nil
 
 		if !fileExists {
-			if noCreate {
+			if o.noCreate {
 				continue
 			}
 			// Create new empty file
-			f, err := os.Create(file)
+			f, err := vfs.Create(ctx.Files(), ctx.Path(file))
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "touch: cannot touch '%s': %v\n", file, err)
+				fmt.Fprintf(ctx.Stderr, "touch: cannot touch '%s': %v\n", file, pathErr(err))
 				exitCode = utils.ExitFailure
 				continue
 			}
 			f.Close()
 		} else {
 			// Update timestamps
-			err := os.Chtimes(file, now, now)
+			err := ctx.Files().Chtimes(ctx.Path(file), now, now)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "touch: cannot touch '%s': %v\n", file, err)
+				fmt.Fprintf(ctx.Stderr, "touch: cannot touch '%s': %v\n", file, pathErr(err))
 				exitCode = utils.ExitFailure
 			}
 		}
@@ -74,19 +83,3 @@ func Touch(args []string) int {
 
 	return exitCode
 }
-
-func printTouchHelp() {
-	fmt.Println(`Usage: touch [OPTION]... FILE...
-
-Update the access and modification times of each FILE to the current time.
-A FILE argument that does not exist is created empty.
-
-Options:
-  -c, --no-create  do not create any files
-  --help           display this help and exit
-
-Examples:
-  touch file.txt
-  touch -c existing.txt
-  touch file1.txt file2.txt`)
-}
@@ -2,36 +2,54 @@ package commands
 
 import (
 	"fmt"
-	"os"
-	"syscall"
 	"time"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
-var (
-	modkernel32       = syscall.NewLazyDLL("kernel32.dll")
-	procGetTickCount64 = modkernel32.NewProc("GetTickCount64")
-)
+// uptimeResult is the structured output of uptime.
+type uptimeResult struct {
+	Time     time.Time `json:"time"`
+	BootTime time.Time `json:"boot_time"`
+	Seconds  int64     `json:"uptime_seconds"`
+}
+
+func newUptimeFlags() *flags.FlagSet {
+	fs := flags.New("uptime", "[OPTION]...")
+	fs.Description = "Display how long the system has been running."
+	fs.Help("h,help")
+	return fs
+}
+
+// UptimeFlags returns the uptime flag set, for help and documentation.
+func UptimeFlags() *flags.FlagSet {
+	return newUptimeFlags()
+}
 
-// Uptime implements the uptime command for Windows.
-func Uptime(args []string) int {
-	// Check for help
-	for _, arg := range args {
-		if arg == "--help" || arg == "-h" {
-			printUptimeHelp()
-			return utils.ExitSuccess
-		}
+// Uptime implements the uptime command.
+func Uptime(ctx *core.Context, args []string) int {
+	fs := newUptimeFlags()
+	if _, err := fs.Parse(args); err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
-	ret, _, _ := procGetTickCount64.Call()
-	if ret == 0 {
-		fmt.Fprintf(os.Stderr, "uptime: failed to get system uptime\n")
+	uptimeDuration, err := systemUptime()
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "uptime: failed to get system uptime\n")
 		return utils.ExitFailure
 	}
-
-	uptimeDuration := time.Duration(ret) * time.Millisecond
 	
+	if ctx.Output.Structured() {
+		now := time.Now().Truncate(time.Second)
+		return writeResult(ctx, "uptime", uptimeResult{
+			Time:     now,
+			BootTime: now.Add(-uptimeDuration).Truncate(time.Second),
+			Seconds:  int64(uptimeDuration.Seconds()),
+		})
+	}
+
 	// Format: up 1 day, 2 hours, 30 minutes
 	days := int(uptimeDuration.Hours()) / 24
 	hours := int(uptimeDuration.Hours()) % 24
@@ -40,19 +58,11 @@ func Uptime(args []string) int {
 	// Get current time
 	now := time.Now().Format("15:04:05")
 
-	fmt.Printf(" %s up ", now)
+	fmt.Fprintf(ctx.Stdout, " %s up ", now)
 	if days > 0 {
-		fmt.Printf("%d day(s), ", days)
+		fmt.Fprintf(ctx.Stdout, "%d day(s), ", days)
 	}
-	fmt.Printf("%02d:%02d\n", hours, minutes)
+	fmt.Fprintf(ctx.Stdout, "%02d:%02d\n", hours, minutes)
 
 	return utils.ExitSuccess
 }
-
-func printUptimeHelp() {
-	fmt.Println(`Usage: uptime [OPTION]...
-Display how long the system has been running.
-
-Options:
-  --help     display this help and exit`)
-}
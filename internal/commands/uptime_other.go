@@ -0,0 +1,24 @@
+//go:build !windows
+
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// systemUptime returns the time since the system started, from
+// /proc/uptime.
+func systemUptime() (time.Duration, error) {
+	data, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return 0, err
+	}
+	secs, err := strconv.ParseFloat(strings.Fields(string(data) + " 0")[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"errors"
+	"syscall"
+	"time"
+)
+
+var (
+	modkernel32        = syscall.NewLazyDLL("kernel32.dll")
+	procGetTickCount64 = modkernel32.NewProc("GetTickCount64")
+)
+
+// systemUptime returns the time since the system started.
+func systemUptime() (time.Duration, error) {
+	ret, _, _ := procGetTickCount64.Call()
+	if ret == 0 {
+		return 0, errors.New("GetTickCount64 failed")
+	}
+	return time.Duration(ret) * time.Millisecond, nil
+}
@@ -2,37 +2,58 @@ package commands
 
 import (
 	"fmt"
-	"os"
 	"os/user"
 
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/flags"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
+// whoamiResult is the structured output of whoami. UID is the security
+// identifier on Windows.
+type whoamiResult struct {
+	User   string `json:"user"`
+	Domain string `json:"domain"`
+	UID    string `json:"uid"`
+}
+
+func newWhoamiFlags() *flags.FlagSet {
+	fs := flags.New("whoami", "[OPTION]...")
+	fs.Description = "Print the user name associated with the current effective user ID."
+	fs.Help("h,help")
+	return fs
+}
+
+// WhoamiFlags returns the whoami flag set, for help and documentation.
+func WhoamiFlags() *flags.FlagSet {
+	return newWhoamiFlags()
+}
+
 // Whoami implements the whoami command.
 // Usage: whoami
-func Whoami(args []string) int {
-	// Check for help
-	for _, arg := range args {
-		if arg == "--help" || arg == "-h" {
-			printWhoamiHelp()
-			return utils.ExitSuccess
-		}
+func Whoami(ctx *core.Context, args []string) int {
+	fs := newWhoamiFlags()
+	if _, err := fs.Parse(args); err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
 	}
 
 	currUser, err := user.Current()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "whoami: %v\n", err)
+		fmt.Fprintf(ctx.Stderr, "whoami: %v\n", err)
 		return utils.ExitFailure
 	}
 
 	// On Windows, Username might include domain (DOMAIN\User).
 	// Linux whoami usually just returns the user part.
-	username := currUser.Username
+	username, domain := currUser.Username, ""
 	if lastSlash := lastIndex(username, '\\'); lastSlash != -1 {
-		username = username[lastSlash+1:]
+		username, domain = username[lastSlash+1:], username[:lastSlash]
 	}
 
-	fmt.Println(username)
+	if ctx.Output.Structured() {
+		return writeResult(ctx, "whoami", whoamiResult{User: username, Domain: domain, UID: currUser.Uid})
+	}
+	fmt.Fprintln(ctx.Stdout, username)
 	return utils.ExitSuccess
 }
 
@@ -44,11 +65,3 @@ func lastIndex(s string, char byte) int {
 	}
 	return -1
 }
-
-func printWhoamiHelp() {
-	fmt.Println(`Usage: whoami [OPTION]...
-Print the user name associated with the current effective user ID.
-
-Options:
-  --help     display this help and exit`)
-}
@@ -0,0 +1,417 @@
+// Package config loads the winux configuration file.
+//
+// The file is TOML, at %APPDATA%\winux\config.toml on Windows and
+// $XDG_CONFIG_HOME/winux/config.toml (or ~/.config/winux/config.toml)
+// elsewhere; WINUX_CONFIG overrides the location:
+//
+//	[defaults]          # options put before the arguments of a command
+//	ls = "-lh"
+//
+//	[aliases]           # new command names
+//	ll = "ls -la"
+//
+//	[commands]
+//	disabled = ["nano"] # commands winux refuses to run
+//
+//	[ui]
+//	color = "auto"      # auto, always or never
+//	locale = "en_US.UTF-8"
+//
+//	[updater]
+//	policy = "notify"   # notify, auto or off
+//
+//	[help]
+//	autocorrect = 15    # run a mistyped command's only close match
+//	                    # after 1.5 seconds; "immediate" or 0 (off)
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvPath names the environment variable that overrides the location
+// of the configuration file.
+const EnvPath = "WINUX_CONFIG"
+
+// Values accepted for ui.color and updater.policy.
+var (
+	ColorValues  = []string{"auto", "always", "never"}
+	PolicyValues = []string{"notify", "auto", "off"}
+)
+
+// Keys are the settings outside [defaults] and [aliases], whose keys
+// are command names.
+var Keys = []string{"commands.disabled", "help.autocorrect", "ui.color", "ui.locale", "updater.policy"}
+
+// Config is the decoded configuration. The zero value, and a nil
+// *Config, mean no settings.
+type Config struct {
+	Path     string
+	Defaults map[string][]string // command name to default arguments
+	Aliases  map[string][]string // alias name to command and arguments
+	Disabled []string
+
+	Color        string // "auto", "always", "never" or "" if unset
+	Locale       string
+	UpdatePolicy string // "notify", "auto", "off" or "" if unset
+
+	// Autocorrect is how long, in tenths of a second, to wait before
+	// running the only command close to a mistyped one: 0 means never
+	// and a negative value means at once.
+	Autocorrect int
+}
+
+// Dir returns the winux configuration directory, %APPDATA%\winux on
+// Windows and $XDG_CONFIG_HOME/winux or ~/.config/winux elsewhere,
+// looking up environment variables with getenv.
+func Dir(getenv func(string) string) string {
+	if runtime.GOOS == "windows" {
+		dir := getenv("APPDATA")
+		if dir == "" {
+			dir = filepath.Join(getenv("USERPROFILE"), "AppData", "Roaming")
+		}
+		return filepath.Join(dir, "winux")
+	}
+	dir := getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		dir = filepath.Join(getenv("HOME"), ".config")
+	}
+	return filepath.Join(dir, "winux")
+}
+
+// Path returns the location of the configuration file: EnvPath if set,
+// or config.toml in Dir.
+func Path(getenv func(string) string) string {
+	if p := getenv(EnvPath); p != "" {
+		return p
+	}
+	return filepath.Join(Dir(getenv), "config.toml")
+}
+
+// Open reads the file at path. A missing file gives an empty File.
+func Open(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &File{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Parse(path, data)
+}
+
+// Save writes f to path, creating its directory.
+func Save(path string, f *File) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, f.Bytes(), 0644)
+}
+
+// Load reads and decodes the configuration at path. A missing file is
+// not an error. On error the returned Config is empty but usable.
+func Load(path string) (*Config, error) {
+	f, err := Open(path)
+	if err != nil {
+		return &Config{Path: path}, err
+	}
+	c, err := Decode(f)
+	if err != nil {
+		return &Config{Path: path}, fmt.Errorf("%s: %v", path, err)
+	}
+	c.Path = path
+	return c, nil
+}
+
+// Decode checks the entries of f and returns the configuration.
+func Decode(f *File) (*Config, error) {
+	c := &Config{
+		Defaults: make(map[string][]string),
+		Aliases:  make(map[string][]string),
+	}
+	for _, e := range f.Entries() {
+		if err := c.set(e); err != nil {
+			return nil, fmt.Errorf("%s: %v", e.Name(), err)
+		}
+	}
+	return c, nil
+}
+
+func (c *Config) set(e Entry) error {
+	switch e.Section {
+	case "defaults", "aliases":
+		s, ok := e.Value.(string)
+		if !ok {
+			return errors.New("expected a string")
+		}
+		words, err := Split(s)
+		if err != nil {
+			return err
+		}
+		if e.Section == "defaults" {
+			c.Defaults[strings.ToLower(e.Key)] = words
+			return nil
+		}
+		if len(words) == 0 {
+			return errors.New("empty alias")
+		}
+		c.Aliases[strings.ToLower(e.Key)] = words
+		return nil
+	}
+
+	switch e.Name() {
+	case "commands.disabled":
+		list, ok := e.Value.([]string)
+		if !ok {
+			return errors.New("expected an array of strings")
+		}
+		for _, name := range list {
+			c.Disabled = append(c.Disabled, strings.ToLower(name))
+		}
+	case "ui.color":
+		return setChoice(&c.Color, e.Value, ColorValues)
+	case "ui.locale":
+		s, ok := e.Value.(string)
+		if !ok {
+			return errors.New("expected a string")
+		}
+		c.Locale = s
+	case "updater.policy":
+		return setChoice(&c.UpdatePolicy, e.Value, PolicyValues)
+	case "help.autocorrect":
+		switch v := e.Value.(type) {
+		case int64:
+			c.Autocorrect = int(v)
+		case string:
+			switch v {
+			case "immediate":
+				c.Autocorrect = -1
+			case "never", "off":
+				c.Autocorrect = 0
+			default:
+				return errors.New("expected tenths of a second, immediate or never")
+			}
+		default:
+			return errors.New("expected tenths of a second, immediate or never")
+		}
+	default:
+		return errors.New("unknown key")
+	}
+	return nil
+}
+
+func setChoice(p *string, v any, choices []string) error {
+	s, _ := v.(string)
+	for _, c := range choices {
+		if s == c {
+			*p = s
+			return nil
+		}
+	}
+	return fmt.Errorf("expected one of %s", strings.Join(choices, ", "))
+}
+
+// DefaultArgs returns the default arguments for the named command.
+func (c *Config) DefaultArgs(name string) []string {
+	if c == nil {
+		return nil
+	}
+	return c.Defaults[strings.ToLower(name)]
+}
+
+// Alias returns the expansion of the named alias.
+func (c *Config) Alias(name string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	words, ok := c.Aliases[strings.ToLower(name)]
+	return words, ok
+}
+
+// AliasNames returns the alias names, sorted.
+func (c *Config) AliasNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, 0, len(c.Aliases))
+	for name := range c.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AutocorrectDelay returns the Autocorrect setting as a duration:
+// zero for never and negative for at once.
+func (c *Config) AutocorrectDelay() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return time.Duration(c.Autocorrect) * 100 * time.Millisecond
+}
+
+// IsDisabled reports whether the named command is disabled.
+func (c *Config) IsDisabled(name string) bool {
+	if c == nil {
+		return false
+	}
+	name = strings.ToLower(name)
+	for _, d := range c.Disabled {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitKey splits a dotted key such as "ui.color" or "aliases.ll" into
+// its section and name, and checks that it is a known setting.
+func SplitKey(key string) (section, name string, err error) {
+	section, name, ok := strings.Cut(key, ".")
+	if !ok || name == "" {
+		return "", "", fmt.Errorf("key '%s' does not contain a section", key)
+	}
+	switch section {
+	case "defaults", "aliases":
+		return section, strings.ToLower(name), nil
+	}
+	for _, k := range Keys {
+		if k == key {
+			return section, name, nil
+		}
+	}
+	return "", "", fmt.Errorf("unknown key '%s'", key)
+}
+
+// ParseValue converts the command-line values of a setting to the type
+// stored for key: for defaults and aliases, a single value as written or
+// several words quoted as needed and joined by spaces; for
+// commands.disabled, a list, split also at commas.
+func ParseValue(key string, values []string) (any, error) {
+	section, _, err := SplitKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	switch {
+	case key == "commands.disabled":
+		list := []string{}
+		for _, s := range values {
+			for _, name := range strings.Split(s, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					list = append(list, name)
+				}
+			}
+		}
+		v = list
+	case section == "defaults" || section == "aliases":
+		if len(values) == 1 {
+			v = values[0]
+			break
+		}
+		words := make([]string, len(values))
+		for i, w := range values {
+			words[i] = quoteWord(w)
+		}
+		v = strings.Join(words, " ")
+	case len(values) != 1:
+		return nil, fmt.Errorf("%s takes one value", key)
+	case key == "help.autocorrect":
+		v = values[0]
+		if n, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+			v = n
+		}
+	default:
+		v = values[0]
+	}
+
+	// Decode the single entry to apply the usual checks.
+	if err := (&Config{
+		Defaults: make(map[string][]string),
+		Aliases:  make(map[string][]string),
+	}).set(Entry{Section: section, Key: key[len(section)+1:], Value: v}); err != nil {
+		return nil, fmt.Errorf("%s: %v", key, err)
+	}
+	return v, nil
+}
+
+// FormatPlain formats a value for display: strings as they are and
+// lists separated by commas.
+func FormatPlain(v any) string {
+	if list, ok := v.([]string); ok {
+		return strings.Join(list, ",")
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return FormatValue(v)
+}
+
+// quoteWord quotes w for Split if it holds blanks or quotes.
+func quoteWord(w string) string {
+	if w != "" && !strings.ContainsAny(w, " \t\n\r'\"") {
+		return w
+	}
+	return "'" + strings.ReplaceAll(w, "'", `'"'"'`) + "'"
+}
+
+// Split splits s into words as a POSIX shell would, honouring single
+// and double quotes and backslash escapes, but without expansions.
+// Backslashes before other characters are kept, so Windows paths need
+// no quoting.
+func Split(s string) ([]string, error) {
+	var words []string
+	var b strings.Builder
+	inWord := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				words = append(words, b.String())
+				b.Reset()
+				inWord = false
+			}
+		case c == '\'':
+			j := strings.IndexByte(s[i+1:], '\'')
+			if j < 0 {
+				return nil, errors.New("unterminated quote")
+			}
+			b.WriteString(s[i+1 : i+1+j])
+			i += j + 1
+			inWord = true
+		case c == '"':
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\') {
+					i++
+				}
+				b.WriteByte(s[i])
+			}
+			if i == len(s) {
+				return nil, errors.New("unterminated quote")
+			}
+			inWord = true
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(" \t'\"\\", s[i+1]) >= 0:
+			i++
+			b.WriteByte(s[i])
+			inWord = true
+		default:
+			b.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, b.String())
+	}
+	return words, nil
+}
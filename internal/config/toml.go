@@ -0,0 +1,383 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// File is a parsed TOML document. It understands the subset winux
+// needs: [section] tables, key = value pairs, strings, booleans,
+// integers and single-line arrays of strings. Comments and layout are
+// kept, so files edited with winux config stay readable.
+type File struct {
+	lines []line
+}
+
+// line is one line of a File.
+type line struct {
+	text    string // the line as written
+	section string // enclosing section, or the name of a header line
+	key     string // empty for headers, comments and blank lines
+	header  bool
+	value   any // string, bool, int64 or []string
+}
+
+// Entry is a key and its value.
+type Entry struct {
+	Section string
+	Key     string
+	Value   any
+}
+
+// Name returns the entry's dotted name, such as "ui.color".
+func (e Entry) Name() string {
+	return e.Section + "." + e.Key
+}
+
+// Parse parses data. Errors are prefixed with name and the line number.
+func Parse(name string, data []byte) (*File, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	f := &File{}
+	section := ""
+	seen := make(map[string]bool)
+	for i, text := range strings.Split(string(data), "\n") {
+		text = strings.TrimSuffix(text, "\r")
+		l := line{text: text, section: section}
+		s := strings.TrimSpace(stripComment(text))
+
+		switch {
+		case s == "":
+		case strings.HasPrefix(s, "["):
+			if !strings.HasSuffix(s, "]") || strings.HasPrefix(s, "[[") {
+				return nil, fmt.Errorf("%s:%d: invalid table header", name, i+1)
+			}
+			key, rest, err := parseKey(strings.TrimSpace(s[1 : len(s)-1]))
+			if err != nil || rest != "" {
+				return nil, fmt.Errorf("%s:%d: invalid table name", name, i+1)
+			}
+			if seen["["+key] {
+				return nil, fmt.Errorf("%s:%d: table [%s] defined twice", name, i+1, key)
+			}
+			seen["["+key] = true
+			section = key
+			l.section, l.header = key, true
+		default:
+			key, rest, err := parseKey(s)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", name, i+1, err)
+			}
+			if !strings.HasPrefix(rest, "=") {
+				return nil, fmt.Errorf("%s:%d: expected '=' after key", name, i+1)
+			}
+			v, err := parseValue(strings.TrimSpace(rest[1:]))
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", name, i+1, err)
+			}
+			if seen[section+"."+key] {
+				return nil, fmt.Errorf("%s:%d: key '%s' defined twice", name, i+1, key)
+			}
+			seen[section+"."+key] = true
+			l.key, l.value = key, v
+		}
+		f.lines = append(f.lines, l)
+	}
+
+	// A trailing newline leaves an empty last line; Bytes adds it back.
+	if n := len(f.lines); n > 0 && f.lines[n-1].text == "" {
+		f.lines = f.lines[:n-1]
+	}
+	return f, nil
+}
+
+// stripComment removes a # comment that is not inside a string.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// parseKey reads a bare or quoted key from the start of s and returns
+// it with the rest of s, trimmed.
+func parseKey(s string) (key, rest string, err error) {
+	if s != "" && (s[0] == '"' || s[0] == '\'') {
+		key, n, err := parseString(s)
+		if err != nil {
+			return "", "", err
+		}
+		return key, strings.TrimSpace(s[n:]), nil
+	}
+	i := 0
+	for i < len(s) && isBareKeyChar(s[i]) {
+		i++
+	}
+	if i == 0 {
+		return "", "", fmt.Errorf("expected a key")
+	}
+	return s[:i], strings.TrimSpace(s[i:]), nil
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// parseValue parses a complete value.
+func parseValue(s string) (any, error) {
+	switch {
+	case s == "":
+		return nil, fmt.Errorf("missing value")
+	case s[0] == '"' || s[0] == '\'':
+		v, n, err := parseString(s)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(s[n:]) != "" {
+			return nil, fmt.Errorf("unexpected text after value")
+		}
+		return v, nil
+	case s[0] == '[':
+		return parseArray(s)
+	case s == "true":
+		return true, nil
+	case s == "false":
+		return false, nil
+	}
+	n, err := strconv.ParseInt(strings.ReplaceAll(s, "_", ""), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value '%s' (strings must be quoted)", s)
+	}
+	return n, nil
+}
+
+// parseArray parses a single-line array of strings.
+func parseArray(s string) ([]string, error) {
+	list := []string{}
+	s = strings.TrimSpace(s[1:])
+	for {
+		if strings.HasPrefix(s, "]") {
+			if strings.TrimSpace(s[1:]) != "" {
+				return nil, fmt.Errorf("unexpected text after value")
+			}
+			return list, nil
+		}
+		if s == "" || (s[0] != '"' && s[0] != '\'') {
+			return nil, fmt.Errorf("arrays must hold quoted strings on one line")
+		}
+		v, n, err := parseString(s)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+		s = strings.TrimSpace(s[n:])
+		if strings.HasPrefix(s, ",") {
+			s = strings.TrimSpace(s[1:])
+		} else if !strings.HasPrefix(s, "]") {
+			return nil, fmt.Errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// parseString parses the basic ("...") or literal ('...') string at the
+// start of s and returns it with the number of bytes read.
+func parseString(s string) (string, int, error) {
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote:
+			return b.String(), i + 1, nil
+		case c == '\\' && quote == '"':
+			i++
+			if i == len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '"', '\\':
+				b.WriteByte(s[i])
+			case 'u', 'U':
+				size := 4
+				if s[i] == 'U' {
+					size = 8
+				}
+				if i+size >= len(s) {
+					return "", 0, fmt.Errorf("invalid escape in string")
+				}
+				r, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+				if err != nil || !utf8.ValidRune(rune(r)) {
+					return "", 0, fmt.Errorf("invalid escape in string")
+				}
+				b.WriteRune(rune(r))
+				i += size
+			default:
+				return "", 0, fmt.Errorf("invalid escape '\\%c' in string", s[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// Get returns the value of key in section.
+func (f *File) Get(section, key string) (any, bool) {
+	if i := f.find(section, key); i >= 0 {
+		return f.lines[i].value, true
+	}
+	return nil, false
+}
+
+// Set sets key in section to v, which must be a string, bool, int64 or
+// []string. An existing entry is replaced in place; a new one goes at
+// the end of its section, which is created if needed.
+func (f *File) Set(section, key string, v any) {
+	l := line{
+		text:    formatKey(key) + " = " + FormatValue(v),
+		section: section,
+		key:     key,
+		value:   v,
+	}
+	if i := f.find(section, key); i >= 0 {
+		f.lines[i] = l
+		return
+	}
+
+	end := -1
+	for i, fl := range f.lines {
+		if fl.section == section && (fl.header || fl.key != "") {
+			end = i
+		}
+	}
+	if end < 0 {
+		if n := len(f.lines); n > 0 && strings.TrimSpace(f.lines[n-1].text) != "" {
+			f.lines = append(f.lines, line{section: section})
+		}
+		f.lines = append(f.lines, line{text: "[" + formatKey(section) + "]", section: section, header: true}, l)
+		return
+	}
+	f.lines = append(f.lines[:end+1], append([]line{l}, f.lines[end+1:]...)...)
+}
+
+// Unset removes key from section and reports whether it was present.
+func (f *File) Unset(section, key string) bool {
+	i := f.find(section, key)
+	if i < 0 {
+		return false
+	}
+	f.lines = append(f.lines[:i], f.lines[i+1:]...)
+	return true
+}
+
+// Entries returns every entry in file order.
+func (f *File) Entries() []Entry {
+	var out []Entry
+	for _, l := range f.lines {
+		if l.key != "" {
+			out = append(out, Entry{l.section, l.key, l.value})
+		}
+	}
+	return out
+}
+
+// Bytes returns the file contents.
+func (f *File) Bytes() []byte {
+	var b bytes.Buffer
+	for _, l := range f.lines {
+		b.WriteString(l.text)
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+func (f *File) find(section, key string) int {
+	for i, l := range f.lines {
+		if l.key != "" && l.section == section && l.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func formatKey(key string) string {
+	for i := 0; i < len(key); i++ {
+		if !isBareKeyChar(key[i]) {
+			return strconv.Quote(key)
+		}
+	}
+	if key == "" {
+		return `""`
+	}
+	return key
+}
+
+// FormatValue formats v as a TOML value.
+func FormatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []string:
+		parts := make([]string, len(v))
+		for i, s := range v {
+			parts[i] = quote(s)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+// quote uses a literal string for values with backslashes, which keeps
+// Windows paths readable, and a basic string otherwise.
+func quote(s string) string {
+	if strings.Contains(s, `\`) && !strings.ContainsAny(s, "'\n\r") {
+		return "'" + s + "'"
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,321 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []Entry
+	}{
+		{"empty", "", nil},
+		{"comments and blanks", "# top\n\n  # indented\n", nil},
+		{"top-level key", `a = "x"`, []Entry{{"", "a", "x"}}},
+		{"table", "[ui]\ncolor = \"never\"\n", []Entry{{"ui", "color", "never"}}},
+		{"tables", "[a]\nx = 1\n[b]\nx = 2\n", []Entry{{"a", "x", int64(1)}, {"b", "x", int64(2)}}},
+		{"spaced header", "[ ui ]\nk = true", []Entry{{"ui", "k", true}}},
+		{"quoted table", "[\"my.table\"]\nk = false", []Entry{{"my.table", "k", false}}},
+		{"quoted key", `"a b" = 'c'`, []Entry{{"", "a b", "c"}}},
+		{"bare key chars", "A-b_9 = 0", []Entry{{"", "A-b_9", int64(0)}}},
+		{"integers", "a = -12\nb = 1_000\nc = +3", []Entry{{"", "a", int64(-12)}, {"", "b", int64(1000)}, {"", "c", int64(3)}}},
+		{"trailing comment", `ll = "ls -la" # long`, []Entry{{"", "ll", "ls -la"}}},
+		{"hash in string", `a = "x # y" # z`, []Entry{{"", "a", "x # y"}}},
+		{"hash in literal", `a = 'x # y'`, []Entry{{"", "a", "x # y"}}},
+		{"escaped quote then hash", `a = "x\" # y"`, []Entry{{"", "a", `x" # y`}}},
+		{"CRLF", "[ui]\r\ncolor = \"auto\"\r\n", []Entry{{"ui", "color", "auto"}}},
+		{"BOM", "\ufeffa = 1", []Entry{{"", "a", int64(1)}}},
+
+		// Strings and escapes.
+		{"basic escapes", `a = "t\tn\nr\rq\"b\\"`, []Entry{{"", "a", "t\tn\nr\rq\"b\\"}}},
+		{"unicode escapes", `a = "\u00e9\U0001F600"`, []Entry{{"", "a", "é😀"}}},
+		{"literal string", `a = 'C:\Users\me'`, []Entry{{"", "a", `C:\Users\me`}}},
+		{"empty strings", `a = ""` + "\n" + `b = ''`, []Entry{{"", "a", ""}, {"", "b", ""}}},
+
+		// Arrays.
+		{"array", `a = ["x", 'y\z']`, []Entry{{"", "a", []string{"x", `y\z`}}}},
+		{"empty array", "a = []", []Entry{{"", "a", []string{}}}},
+		{"trailing comma", `a = [ "x" , ]`, []Entry{{"", "a", []string{"x"}}}},
+		{"array with comment", `a = ["#"] # c`, []Entry{{"", "a", []string{"#"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse("config.toml", []byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Entries(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entries = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"[ui", "config.toml:1: invalid table header"},
+		{"[[plugins]]", "config.toml:1: invalid table header"},
+		{"[]", "config.toml:1: invalid table name"},
+		{"[a b]", "config.toml:1: invalid table name"},
+		{"[a]\n[b]\n[a]", "config.toml:3: table [a] defined twice"},
+		{"a = 1\n\na = 2", "config.toml:3: key 'a' defined twice"},
+		{"[x]\na = 1\n[y]\na = 2", ""},
+		{"# c\n= 1", "config.toml:2: expected a key"},
+		{"a 1", "config.toml:1: expected '=' after key"},
+		{"a =", "config.toml:1: missing value"},
+		{"a = yes", "config.toml:1: invalid value 'yes' (strings must be quoted)"},
+		{"a = 1.5", "config.toml:1: invalid value '1.5' (strings must be quoted)"},
+		{"\n\na = \"open", "config.toml:3: unterminated string"},
+		{"a = 'open", "config.toml:1: unterminated string"},
+		{`a = "x" y`, "config.toml:1: unexpected text after value"},
+		{`a = "\q"`, `config.toml:1: invalid escape '\q' in string`},
+		{`a = "\u12"`, "config.toml:1: invalid escape in string"},
+		{`a = "\uD800"`, "config.toml:1: invalid escape in string"},
+		{"a = [1, 2]", "config.toml:1: arrays must hold quoted strings on one line"},
+		{"a = [\n\"x\"]", "config.toml:1: arrays must hold quoted strings on one line"},
+		{`a = ["x" "y"]`, "config.toml:1: expected ',' or ']' in array"},
+		{`a = ["x"] z`, "config.toml:1: unexpected text after value"},
+	}
+	for _, tt := range tests {
+		_, err := Parse("config.toml", []byte(tt.src))
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("Parse(%q): %v", tt.src, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestEdit(t *testing.T) {
+	src := `# winux settings
+
+[ui]
+color = "never" # no colour please
+
+[aliases]
+ll = "ls -la"
+`
+	f, err := Parse("config.toml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(f.Bytes()); got != src {
+		t.Fatalf("round trip changed the file:\n%s", got)
+	}
+
+	f.Set("ui", "color", "auto")
+	f.Set("aliases", "la", "ls -A")
+	f.Set("defaults", "grep", `--exclude-dir=C:\tmp`)
+	f.Set("commands", "disabled", []string{"rm", "dd"})
+	if !f.Unset("aliases", "ll") || f.Unset("aliases", "nope") {
+		t.Error("Unset reported the wrong result")
+	}
+
+	want := `# winux settings
+
+[ui]
+color = "auto"
+
+[aliases]
+la = "ls -A"
+
+[defaults]
+grep = '--exclude-dir=C:\tmp'
+
+[commands]
+disabled = ["rm", "dd"]
+`
+	if got := string(f.Bytes()); got != want {
+		t.Errorf("edited file:\n%s\nwant:\n%s", got, want)
+	}
+
+	// What Set writes must parse back to the same values.
+	g, err := Parse("config.toml", f.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(g.Entries(), f.Entries()) {
+		t.Errorf("reparsed entries = %#v, want %#v", g.Entries(), f.Entries())
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{"plain", `"plain"`},
+		{`C:\path`, `'C:\path'`},
+		{`it's C:\`, `"it's C:\\"`},
+		{"tab\there\n\x01", `"tab\there\n\u0001"`},
+		{true, "true"},
+		{int64(-3), "-3"},
+		{[]string{}, "[]"},
+		{[]string{"a", `b\c`}, `["a", 'b\c']`},
+	}
+	for _, tt := range tests {
+		got := FormatValue(tt.v)
+		if got != tt.want {
+			t.Errorf("FormatValue(%#v) = %s, want %s", tt.v, got, tt.want)
+		}
+		f, err := Parse("t", []byte("k = "+got))
+		if err != nil {
+			t.Errorf("Parse(%s): %v", got, err)
+			continue
+		}
+		if v, _ := f.Get("", "k"); !reflect.DeepEqual(v, tt.v) {
+			t.Errorf("%s parses back as %#v", got, v)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	src := `[defaults]
+LS = "--color=auto -F"
+[aliases]
+ll = "ls -la"
+g = "grep -rn --exclude-dir='node modules'"
+[commands]
+disabled = ["RM"]
+[ui]
+color = "always"
+locale = "de_DE"
+[updater]
+policy = "off"
+[help]
+autocorrect = "immediate"
+`
+	f, err := Parse("config.toml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.DefaultArgs("ls"); !reflect.DeepEqual(got, []string{"--color=auto", "-F"}) {
+		t.Errorf("DefaultArgs(ls) = %q", got)
+	}
+	if got, ok := c.Alias("G"); !ok || !reflect.DeepEqual(got, []string{"grep", "-rn", "--exclude-dir=node modules"}) {
+		t.Errorf("Alias(G) = %q, %v", got, ok)
+	}
+	if got := c.AliasNames(); !reflect.DeepEqual(got, []string{"g", "ll"}) {
+		t.Errorf("AliasNames = %q", got)
+	}
+	if !c.IsDisabled("rm") || c.IsDisabled("ls") {
+		t.Errorf("Disabled = %q", c.Disabled)
+	}
+	if c.Color != "always" || c.Locale != "de_DE" || c.UpdatePolicy != "off" || c.Autocorrect != -1 {
+		t.Errorf("decoded %+v", c)
+	}
+
+	var nilConfig *Config
+	if nilConfig.DefaultArgs("ls") != nil || nilConfig.IsDisabled("ls") || nilConfig.AutocorrectDelay() != 0 {
+		t.Error("a nil Config has settings")
+	}
+	if _, ok := nilConfig.Alias("ll"); ok {
+		t.Error("a nil Config has aliases")
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"[ui]\ncolor = \"sometimes\"", "ui.color: expected one of auto, always, never"},
+		{"[ui]\nfont = \"x\"", "ui.font: unknown key"},
+		{"[aliases]\nll = \"\"", "aliases.ll: empty alias"},
+		{"[aliases]\nll = 1", "aliases.ll: expected a string"},
+		{"[defaults]\nls = \"'open\"", "defaults.ls: unterminated quote"},
+		{"[commands]\ndisabled = \"rm\"", "commands.disabled: expected an array of strings"},
+		{"[help]\nautocorrect = true", "help.autocorrect: expected tenths of a second, immediate or never"},
+	}
+	for _, tt := range tests {
+		f, err := Parse("config.toml", []byte(tt.src))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.src, err)
+			continue
+		}
+		_, err = Decode(f)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Decode(%q) error = %v, want %q", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"  ls   -la ", []string{"ls", "-la"}},
+		{`grep 'a b' "c \"d\""`, []string{"grep", "a b", `c "d"`}},
+		{`a\ b c\'d`, []string{"a b", "c'd"}},
+		{`dir C:\Users\me`, []string{"dir", `C:\Users\me`}},
+		{`x '' ""`, []string{"x", "", ""}},
+	}
+	for _, tt := range tests {
+		got, err := Split(tt.s)
+		if err != nil {
+			t.Errorf("Split(%q): %v", tt.s, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Split(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+	for _, s := range []string{`'open`, `"open`, `"a\"`} {
+		if _, err := Split(s); err == nil {
+			t.Errorf("Split(%q) succeeded", s)
+		}
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		key    string
+		values []string
+		want   any
+		err    string
+	}{
+		{"aliases.ll", []string{"ls -la"}, "ls -la", ""},
+		{"aliases.g", []string{"grep", "a b", "it's"}, `grep 'a b' 'it'"'"'s'`, ""},
+		{"commands.disabled", []string{"rm,dd", " mv "}, []string{"rm", "dd", "mv"}, ""},
+		{"help.autocorrect", []string{"10"}, int64(10), ""},
+		{"help.autocorrect", []string{"never"}, "never", ""},
+		{"ui.color", []string{"auto"}, "auto", ""},
+		{"ui.color", []string{"blue"}, nil, "ui.color: expected one of auto, always, never"},
+		{"ui.color", []string{"a", "b"}, nil, "ui.color takes one value"},
+		{"ui", []string{"x"}, nil, "key 'ui' does not contain a section"},
+		{"ui.font", []string{"x"}, nil, "unknown key 'ui.font'"},
+	}
+	for _, tt := range tests {
+		got, err := ParseValue(tt.key, tt.values)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("ParseValue(%s, %q) error = %v, want %q", tt.key, tt.values, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseValue(%s, %q): %v", tt.key, tt.values, err)
+		} else if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseValue(%s, %q) = %#v, want %#v", tt.key, tt.values, got, tt.want)
+		}
+	}
+
+	// An alias set from several words splits back into the same words.
+	v, _ := ParseValue("aliases.g", []string{"grep", "a b", "it's"})
+	if words, _ := Split(v.(string)); !reflect.DeepEqual(words, []string{"grep", "a b", "it's"}) {
+		t.Errorf("Split(%q) = %q", v, words)
+	}
+}
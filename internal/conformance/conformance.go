@@ -0,0 +1,403 @@
+// Package conformance runs golden-file tests of winux commands.
+//
+// Each case is a directory holding an args file and the expected
+// results of running it:
+//
+//	args     the command line; the first word names the command,
+//	         after any --output=FORMAT or --json as given to winux
+//	stdin    standard input (optional; empty if missing)
+//	env      extra environment variables, one NAME=VALUE per line
+//	         (optional)
+//	in/      files and directories placed in the working directory;
+//	         names git would not keep are stored escaped (see load)
+//	times    modification times for files in in/, one "NAME TIME" per
+//	         line with TIME in RFC 3339 form (optional; Clock if unset)
+//	attrs    Windows attributes for files in in/, one "NAME LETTERS"
+//	         per line with LETTERS from "arhsl" (optional)
+//	stdout   expected standard output
+//	stderr   expected standard error
+//	exit     expected exit code
+//	tree     expected contents of the working directory afterwards
+//	notes    where winux intentionally differs from GNU coreutils
+//	         (optional; logged with the case)
+//
+// Commands run against an in-memory filesystem with the working
+// directory /work, a fixed clock and fixed metadata (see Meta), so the
+// results are the same on every machine. Run the tests with -update to rewrite the expected
+// files from the actual results, then review the diff.
+package conformance
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CRTYPUBG/winux/internal/config"
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/fsmeta"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected results of conformance cases")
+
+// WorkDir is the working directory of every case.
+const WorkDir = "/work"
+
+// Clock is the time of every file created or modified during a case.
+var Clock = time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+// Run runs each case found under dir as a subtest named after its path,
+// looking up the command in commands.
+func Run(t *testing.T, dir string, commands map[string]core.CommandFunc) {
+	t.Helper()
+	var cases []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == "in" {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() && d.Name() == "args" {
+			cases = append(cases, filepath.Dir(p))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) == 0 {
+		t.Fatalf("no cases in %s", dir)
+	}
+	for _, c := range cases {
+		c := c
+		name, _ := filepath.Rel(dir, c)
+		t.Run(filepath.ToSlash(name), func(t *testing.T) {
+			runCase(t, c, commands)
+		})
+	}
+}
+
+// result is what running a case produced.
+type result struct {
+	stdout, stderr string
+	exit           int
+	tree           string
+}
+
+func runCase(t *testing.T, dir string, commands map[string]core.CommandFunc) {
+	if notes, err := os.ReadFile(filepath.Join(dir, "notes")); err == nil {
+		t.Log(strings.TrimSpace(string(notes)))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "args"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	words, err := config.Split(strings.TrimSpace(string(data)))
+	if err != nil {
+		t.Fatalf("args: %v", err)
+	}
+	format := output.Text
+	for len(words) > 0 && strings.HasPrefix(words[0], "--") {
+		name := strings.TrimPrefix(words[0], "--output=")
+		if words[0] == "--json" {
+			name = "json"
+		}
+		if format, err = output.Parse(name); err != nil {
+			t.Fatalf("args: %v", err)
+		}
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		t.Fatal("args: empty command line")
+	}
+	run, ok := commands[words[0]]
+	if !ok {
+		t.Fatalf("args: unknown command %q", words[0])
+	}
+
+	fsys := vfs.NewMem(func() time.Time { return Clock })
+	if err := load(fsys, filepath.Join(dir, "in")); err != nil {
+		t.Fatal(err)
+	}
+	if err := setTimes(fsys, filepath.Join(dir, "times")); err != nil {
+		t.Fatalf("times: %v", err)
+	}
+	meta, err := loadAttrs(filepath.Join(dir, "attrs"))
+	if err != nil {
+		t.Fatalf("attrs: %v", err)
+	}
+	stdin, err := os.ReadFile(filepath.Join(dir, "stdin"))
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	ctx := core.NewContext()
+	ctx.Stdin = bytes.NewReader(stdin)
+	ctx.Stdout, ctx.Stderr = &stdout, &stderr
+	ctx.Dir = WorkDir
+	// Hidden files are dotfiles on every platform unless a case says
+	// otherwise.
+	ctx.Env = []string{"HOME=/home/winux", "USER=winux", fsmeta.HiddenEnv + "=dotfiles"}
+	if env, err := os.ReadFile(filepath.Join(dir, "env")); err == nil {
+		ctx.Env = append(ctx.Env, strings.Split(strings.TrimRight(string(env), "\n"), "\n")...)
+	} else if !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	ctx.FS = fsys
+	ctx.Meta = meta
+	ctx.Output = format
+
+	exit := run(ctx, words[1:])
+	got := result{
+		stdout: normalize(stdout.String()),
+		stderr: normalize(stderr.String()),
+		exit:   exit,
+	}
+	got.tree, err = listTree(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		if err := save(dir, got); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := expected(dir)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	check(t, "stdout", got.stdout, want.stdout)
+	check(t, "stderr", got.stderr, want.stderr)
+	check(t, "tree", got.tree, want.tree)
+	if got.exit != want.exit {
+		t.Errorf("exit code %d, want %d", got.exit, want.exit)
+	}
+}
+
+// Meta is the metadata of every file in a case: owned by user winux
+// (1000) and group users (100), with one link, or two for directories,
+// no file ID, and the attributes listed in the case.
+type Meta struct {
+	Attrs map[string]fsmeta.Attributes // by slash path
+}
+
+func (m Meta) Lookup(path string, info fs.FileInfo) fsmeta.Meta {
+	meta := fsmeta.Meta{Links: 1, UID: "1000", GID: "100", Owner: "winux", Group: "users"}
+	if info.IsDir() {
+		meta.Links = 2
+	}
+	meta.Attrs = m.Attributes(path, info)
+	return meta
+}
+
+func (m Meta) Attributes(path string, info fs.FileInfo) fsmeta.Attributes {
+	return m.Attrs[filepath.ToSlash(filepath.Clean(path))]
+}
+
+// loadAttrs returns the Meta for the attributes listed in the file
+// attrs, if it exists.
+func loadAttrs(attrs string) (Meta, error) {
+	m := Meta{Attrs: make(map[string]fsmeta.Attributes)}
+	data, err := os.ReadFile(attrs)
+	if os.IsNotExist(err) {
+		return m, nil
+	} else if err != nil {
+		return m, err
+	}
+	letters := map[rune]fsmeta.Attributes{
+		'a': fsmeta.Archive, 'r': fsmeta.ReadOnly, 'h': fsmeta.Hidden,
+		's': fsmeta.System, 'l': fsmeta.ReparsePoint,
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		name, value, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			return m, fmt.Errorf("want NAME LETTERS: %q", line)
+		}
+		var a fsmeta.Attributes
+		for _, c := range strings.TrimSpace(value) {
+			if letters[c] == 0 {
+				return m, fmt.Errorf("unknown attribute %q in %q", c, line)
+			}
+			a |= letters[c]
+		}
+		m.Attrs[path.Join(WorkDir, name)] = a
+	}
+	return m, nil
+}
+
+// fixtureSuffix escapes names in in/ that git would not commit, such
+// as .git directories, or would let ignore other files, such as
+// .gitignore. It is removed when the tree is loaded.
+const fixtureSuffix = ".fixture"
+
+// load copies the tree at dir into the working directory of fsys. A
+// missing dir leaves the working directory empty.
+//
+// Names ending in ".fixture" lose the suffix, so in/.git.fixture/ is
+// loaded as .git/, and files named just ".fixture" are skipped, so they
+// can keep otherwise empty directories in git.
+func load(fsys *vfs.Mem, dir string) error {
+	if err := fsys.MkdirAll(WorkDir, 0755); err != nil {
+		return err
+	}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == fixtureSuffix {
+			return nil
+		}
+		rel, _ := filepath.Rel(dir, p)
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		for i, part := range parts {
+			parts[i] = strings.TrimSuffix(part, fixtureSuffix)
+		}
+		name := path.Join(WorkDir, path.Join(parts...))
+		if d.IsDir() {
+			return fsys.MkdirAll(name, 0755)
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return fsys.AddFile(name, string(data), 0644)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// setTimes applies the modification times listed in the file times,
+// if it exists.
+func setTimes(fsys *vfs.Mem, times string) error {
+	data, err := os.ReadFile(times)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		name, value, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			return fmt.Errorf("want NAME TIME: %q", line)
+		}
+		mtime, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+		if err != nil {
+			return err
+		}
+		if err := fsys.Chtimes(path.Join(WorkDir, name), mtime, mtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTree describes the working directory of fsys, one line per file
+// or directory: its mode, its size if it is a file, and its name.
+func listTree(fsys *vfs.Mem) (string, error) {
+	var b strings.Builder
+	err := vfs.Walk(fsys, WorkDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(filepath.ToSlash(p), WorkDir)
+		switch {
+		case rel == "":
+			return nil
+		case d.IsDir():
+			fmt.Fprintf(&b, "%v %s/\n", info.Mode(), rel[1:])
+		default:
+			fmt.Fprintf(&b, "%v %d %s\n", info.Mode(), info.Size(), rel[1:])
+		}
+		return nil
+	})
+	return b.String(), err
+}
+
+// normalize makes output independent of the path separator, and of
+// line endings like the expected files, which a checkout may convert.
+func normalize(s string) string {
+	if filepath.Separator == '\\' {
+		s = strings.ReplaceAll(s, `\`, "/")
+	}
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
+
+func expected(dir string) (result, error) {
+	var r result
+	for _, f := range []struct {
+		name string
+		s    *string
+	}{{"stdout", &r.stdout}, {"stderr", &r.stderr}, {"tree", &r.tree}} {
+		data, err := os.ReadFile(filepath.Join(dir, f.name))
+		if err != nil {
+			return r, err
+		}
+		*f.s = strings.ReplaceAll(string(data), "\r\n", "\n")
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "exit"))
+	if err != nil {
+		return r, err
+	}
+	r.exit, err = strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return r, fmt.Errorf("exit: %v", err)
+	}
+	return r, nil
+}
+
+func save(dir string, r result) error {
+	for _, f := range []struct{ name, data string }{
+		{"stdout", r.stdout},
+		{"stderr", r.stderr},
+		{"exit", strconv.Itoa(r.exit) + "\n"},
+		{"tree", r.tree},
+	} {
+		if err := os.WriteFile(filepath.Join(dir, f.name), []byte(f.data), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check reports a difference between got and want, showing the first
+// line that differs.
+func check(t *testing.T, what, got, want string) {
+	t.Helper()
+	if got == want {
+		return
+	}
+	g, w := strings.SplitAfter(got, "\n"), strings.SplitAfter(want, "\n")
+	for i := 0; ; i++ {
+		var gl, wl string
+		if i < len(g) {
+			gl = g[i]
+		}
+		if i < len(w) {
+			wl = w[i]
+		}
+		if gl != wl {
+			t.Errorf("%s differs at line %d:\ngot:  %q\nwant: %q\n\nfull %s:\n%s", what, i+1, gl, wl, what, got)
+			return
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package core
+
+// commandLine reports that no raw command line is available; the
+// invoking shell has already expanded wildcards.
+func commandLine() (string, bool) {
+	return "", false
+}
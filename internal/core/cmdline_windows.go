@@ -0,0 +1,19 @@
+package core
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// commandLine returns the raw command line of the process.
+func commandLine() (string, bool) {
+	p := syscall.GetCommandLine()
+	if p == nil {
+		return "", false
+	}
+	n := 0
+	for *(*uint16)(unsafe.Add(unsafe.Pointer(p), n*2)) != 0 {
+		n++
+	}
+	return syscall.UTF16ToString(unsafe.Slice(p, n)), true
+}
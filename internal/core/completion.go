@@ -0,0 +1,377 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// CompleteFunc suggests completions for cur, the word being typed,
+// given the operands before it.
+type CompleteFunc func(ctx *Context, args []string, cur string) []string
+
+// completionShells lists the shells `winux completion` supports.
+var completionShells = []string{"bash", "fish", "powershell", "zsh"}
+
+func newCompletionFlags() *flags.FlagSet {
+	fs := flags.New("completion", "SHELL")
+	fs.Description = `Print a tab-completion script for SHELL: bash, fish, powershell or zsh.
+The script asks winux for candidates, so it stays current as commands
+and options change: command names after winux, options after '-',
+and file names everywhere else.`
+	fs.Examples = []string{
+		"winux completion powershell | Out-String | Invoke-Expression",
+		`echo 'eval "$(winux completion bash)"' >> ~/.bashrc`,
+		"winux completion zsh > \"${fpath[1]}/_winux\"",
+		"winux completion fish > ~/.config/fish/completions/winux.fish",
+	}
+	return fs
+}
+
+// CompletionFlags returns the completion flag set, for help and
+// documentation.
+func CompletionFlags() *flags.FlagSet {
+	return newCompletionFlags()
+}
+
+// Completion implements the completion command.
+// Usage: completion <bash|fish|powershell|zsh>
+func Completion(ctx *Context, args []string) int {
+	fs := newCompletionFlags()
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if len(args) != 1 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("expected one SHELL argument"))
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "bash":
+		io.WriteString(ctx.Stdout, bashCompletion)
+	case "zsh":
+		io.WriteString(ctx.Stdout, zshCompletion)
+	case "fish":
+		io.WriteString(ctx.Stdout, fishCompletion)
+	case "powershell", "pwsh":
+		io.WriteString(ctx.Stdout, powershellCompletion)
+	default:
+		return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("unsupported shell '%s'", args[0]))
+	}
+	return utils.ExitSuccess
+}
+
+// CompleteShells completes the operand of winux completion.
+func CompleteShells(ctx *Context, args []string, cur string) []string {
+	if len(args) > 0 {
+		return nil
+	}
+	return withPrefix(completionShells, cur)
+}
+
+// CompleteCommands completes command, alias and plugin names, as for
+// winux help.
+func CompleteCommands(ctx *Context, args []string, cur string) []string {
+	if len(args) > 0 {
+		return nil
+	}
+	return withPrefix(allNames(ctx), cur)
+}
+
+// Complete implements the hidden __complete command called by the
+// completion scripts. Its arguments are the number of words after
+// "winux" up to the cursor, then those words; the last one is being
+// typed. PowerShell drops empty arguments, so a missing last word is
+// taken to be empty. Candidates are printed one per line.
+func Complete(ctx *Context, args []string) int {
+	if len(args) == 0 {
+		return utils.ExitUsageError
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		return utils.ExitUsageError
+	}
+	words := args[1:]
+	for len(words) < n {
+		words = append(words, "")
+	}
+	words = words[:n]
+
+	for _, c := range complete(ctx, words) {
+		fmt.Fprintln(ctx.Stdout, c)
+	}
+	return utils.ExitSuccess
+}
+
+// complete returns the candidates for the last of words.
+func complete(ctx *Context, words []string) []string {
+	// Skip global options.
+options:
+	for len(words) > 1 {
+		switch w := words[0]; {
+		case w == "--output" && len(words) == 2:
+			return withPrefix(output.Names(), words[1])
+		case w == "--output":
+			words = words[2:]
+		case w == "--no-glob", w == "--json", strings.HasPrefix(w, "--output="):
+			words = words[1:]
+		default:
+			break options
+		}
+	}
+	cur := words[len(words)-1]
+
+	if len(words) == 1 {
+		if strings.HasPrefix(cur, "--output=") {
+			var formats []string
+			for _, f := range output.Names() {
+				formats = append(formats, "--output="+f)
+			}
+			return withPrefix(formats, cur)
+		}
+		if strings.HasPrefix(cur, "-") {
+			var opts []string
+			for _, opt := range globalOptions {
+				for _, name := range strings.Split(opt[0], ", ") {
+					// Drop placeholders such as "--install-links DIR".
+					opts = append(opts, strings.Fields(name)[0])
+				}
+			}
+			return withPrefix(opts, cur)
+		}
+		return withPrefix(allNames(ctx), cur)
+	}
+
+	cmd, ok := Registry[strings.ToLower(words[0])]
+	if !ok {
+		return completeFiles(ctx, cur)
+	}
+
+	var fs *flags.FlagSet
+	if cmd.Flags != nil {
+		fs = cmd.Flags()
+	}
+
+	// Collect operands, skipping options and their arguments.
+	var operands []string
+	argNext := false
+	for _, w := range words[1 : len(words)-1] {
+		switch {
+		case argNext:
+			argNext = false
+		case w == "--":
+			fs = nil
+		case fs != nil && strings.HasPrefix(w, "-") && w != "-":
+			argNext = needsArg(fs, w)
+		default:
+			operands = append(operands, w)
+		}
+	}
+
+	if argNext {
+		// The argument of an option such as --regexp.
+		return completeFiles(ctx, cur)
+	}
+	if fs != nil && strings.HasPrefix(cur, "-") {
+		return withPrefix(flagNames(fs), cur)
+	}
+	if cmd.Complete != nil {
+		return cmd.Complete(ctx, operands, cur)
+	}
+	return completeFiles(ctx, cur)
+}
+
+// needsArg reports whether the option word w consumes the next word.
+func needsArg(fs *flags.FlagSet, w string) bool {
+	if strings.HasPrefix(w, "--") {
+		if strings.Contains(w, "=") {
+			return false
+		}
+		for _, f := range fs.Flags() {
+			for _, n := range f.Names {
+				if len(n) > 1 && n == w[2:] {
+					return f.ArgRequired()
+				}
+			}
+		}
+		return false
+	}
+	for i := 1; i < len(w); i++ {
+		f := shortFlag(fs, w[i:i+1])
+		if f == nil {
+			return false
+		}
+		if f.Arg != "" {
+			// The rest of the bundle, if any, is the argument.
+			return i == len(w)-1 && f.ArgRequired()
+		}
+	}
+	return false
+}
+
+func shortFlag(fs *flags.FlagSet, name string) *flags.Flag {
+	for _, f := range fs.Flags() {
+		for _, n := range f.Names {
+			if n == name {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+func flagNames(fs *flags.FlagSet) []string {
+	var names []string
+	for _, f := range fs.Flags() {
+		for _, n := range f.Names {
+			if len(n) == 1 {
+				names = append(names, "-"+n)
+			} else {
+				names = append(names, "--"+n)
+			}
+		}
+	}
+	return names
+}
+
+func commandNames() []string {
+	var names []string
+	for _, cmd := range commands() {
+		names = append(names, cmd.Name)
+	}
+	return names
+}
+
+// allNames returns the names of commands, aliases and plugins.
+func allNames(ctx *Context) []string {
+	return append(append(commandNames(), ctx.Config.AliasNames()...), pluginNames(ctx)...)
+}
+
+func withPrefix(list []string, prefix string) []string {
+	var out []string
+	for _, s := range list {
+		if strings.HasPrefix(s, prefix) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// completeFiles lists the files and directories starting with cur,
+// relative to ctx.Dir. Directories end in a separator so the shell
+// keeps completing inside them.
+func completeFiles(ctx *Context, cur string) []string {
+	sep := string(filepath.Separator)
+	dir, prefix := "", cur
+	if i := strings.LastIndexAny(cur, `/\`); i >= 0 {
+		sep = cur[i : i+1]
+		dir, prefix = cur[:i+1], cur[i+1:]
+	}
+
+	lookup := dir
+	if lookup == "" {
+		lookup = "."
+	}
+	entries, err := os.ReadDir(ctx.Path(lookup))
+	if err != nil {
+		return nil
+	}
+
+	var out []string
+	for _, e := range entries {
+		name := e.Name()
+		if !hasPathPrefix(name, prefix) {
+			continue
+		}
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".") {
+			continue
+		}
+		if e.IsDir() {
+			name += sep
+		}
+		out = append(out, dir+name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// hasPathPrefix compares file names case-insensitively on Windows.
+func hasPathPrefix(name, prefix string) bool {
+	if runtime.GOOS == "windows" {
+		return strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix))
+	}
+	return strings.HasPrefix(name, prefix)
+}
+
+const bashCompletion = `# bash completion for winux
+# Load with: eval "$(winux completion bash)"
+_winux() {
+    local IFS=$'\n'
+    local words=("${COMP_WORDS[@]:1:COMP_CWORD}")
+    COMPREPLY=($(winux __complete "$COMP_CWORD" "${words[@]}" 2>/dev/null))
+    if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == */ ]]; then
+        compopt -o nospace 2>/dev/null
+    fi
+}
+complete -F _winux winux winux.exe
+`
+
+const zshCompletion = `#compdef winux winux.exe
+# zsh completion for winux
+# Load with: source <(winux completion zsh)
+_winux() {
+    local -a files dirs
+    local c
+    for c in "${(@f)$(winux __complete $((CURRENT - 1)) "${(@)words[2,CURRENT]}" 2>/dev/null)}"; do
+        [[ -z $c ]] && continue
+        if [[ $c == */ || $c == *\\ ]]; then
+            dirs+=("$c")
+        else
+            files+=("$c")
+        fi
+    done
+    (( ${#files} )) && compadd -- "${files[@]}"
+    (( ${#dirs} )) && compadd -S '' -- "${dirs[@]}"
+}
+compdef _winux winux winux.exe
+`
+
+const fishCompletion = `# fish completion for winux
+# Load with: winux completion fish | source
+function __winux_complete
+    set -l words (commandline -opc)[2..-1] (commandline -ct)
+    winux __complete (count $words) $words 2>/dev/null
+end
+complete -c winux -f -a '(__winux_complete)'
+complete -c winux.exe -f -a '(__winux_complete)'
+`
+
+const powershellCompletion = `# PowerShell completion for winux
+# Load with: winux completion powershell | Out-String | Invoke-Expression
+Register-ArgumentCompleter -Native -CommandName 'winux', 'winux.exe' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $words = @($commandAst.CommandElements |
+        Select-Object -Skip 1 |
+        Where-Object { $_.Extent.StartOffset -lt $cursorPosition } |
+        ForEach-Object { $_.Extent.Text })
+    $count = $words.Count
+    if ($wordToComplete -eq '') { $count++ }
+
+    & winux __complete $count @words 2>$null | ForEach-Object {
+        $text = $_
+        if ($text -match '\s') { $text = "'" + $text + "'" }
+        [System.Management.Automation.CompletionResult]::new($text, $_, 'ParameterValue', $_)
+    }
+}
+`
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/config"
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// maxAliasDepth bounds alias expansion, so an alias may name another.
+const maxAliasDepth = 16
+
+// loadConfig reads the user's configuration for ctx. Errors are
+// reported and leave the configuration empty, so a broken file never
+// stops winux from running.
+func loadConfig(ctx *Context) *config.Config {
+	conf, err := config.Load(config.Path(ctx.Getenv))
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "winux: config: %v\n", err)
+	}
+	if conf.Locale != "" {
+		if _, ok := ctx.LookupEnv("LANG"); !ok {
+			ctx.Setenv("LANG", conf.Locale)
+		}
+	}
+	return conf
+}
+
+// resolve finds the command named by args[0]: a built-in command, an
+// alias, which is expanded, or a plugin. It returns the command with
+// its arguments: the configured defaults, then the rest of args.
+func resolve(ctx *Context, args []arg) (*Command, []arg, error) {
+	for depth := 0; ; depth++ {
+		name := strings.ToLower(args[0].value)
+		cmd, ok := Registry[name]
+		if !ok {
+			if words, isAlias := ctx.Config.Alias(name); isAlias {
+				if depth == maxAliasDepth {
+					return nil, nil, fmt.Errorf("alias '%s' expands too deeply; check for an alias loop", name)
+				}
+				args = append(literalArgs(words), args[1:]...)
+				continue
+			}
+			if p, isPlugin := findPlugin(ctx, name); isPlugin {
+				cmd, ok = p.Command(), true
+			}
+		}
+		if !ok {
+			return nil, nil, &notFoundError{name}
+		}
+		if ctx.Config.IsDisabled(name) {
+			return nil, nil, fmt.Errorf("'%s' is disabled in %s", name, ctx.Config.Path)
+		}
+		return cmd, append(literalArgs(ctx.Config.DefaultArgs(name)), args[1:]...), nil
+	}
+}
+
+// Lookup resolves the command line args as the dispatcher does, for
+// commands run by pipe and sh: aliases are expanded, disabled commands
+// refused and the configured defaults inserted. It returns the command
+// and the arguments to run it with, or an error matching ErrNotFound
+// if args[0] names no command.
+func Lookup(ctx *Context, args []string) (*Command, []string, error) {
+	cmd, resolved, err := resolve(ctx, literalArgs(args))
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make([]string, len(resolved))
+	for i, a := range resolved {
+		out[i] = a.value
+	}
+	return cmd, out, nil
+}
+
+// literalArgs returns words as arguments that are never expanded.
+func literalArgs(words []string) []arg {
+	out := make([]arg, len(words))
+	for i, w := range words {
+		out[i] = arg{value: w}
+	}
+	return out
+}
+
+// configOptions holds the parsed config flags.
+type configOptions struct {
+	file string // --file: the file to edit instead of the user's
+}
+
+func newConfigFlags(o *configOptions) *flags.FlagSet {
+	fs := flags.New("config", `[OPTION]... get KEY
+  or:  config [OPTION]... set KEY VALUE...
+  or:  config [OPTION]... unset KEY
+  or:  config [OPTION]... list
+  or:  config [OPTION]... path`)
+	fs.Description = `Read and change the winux configuration file, a TOML file at
+%APPDATA%\winux\config.toml on Windows or ~/.config/winux/config.toml
+elsewhere; WINUX_CONFIG names another file.
+
+KEY is SECTION.NAME:
+  defaults.COMMAND   options put before the arguments of COMMAND
+  aliases.NAME       a new command, such as "ls -la"
+  commands.disabled  commands winux refuses to run, separated by commas
+  help.autocorrect   tenths of a second to wait before running the only
+                     command close to a mistyped one; immediate or never
+  ui.color           auto, always or never
+  ui.locale          the locale given to programs when LANG is unset
+  updater.policy     notify, auto (install updates) or off`
+	fs.StopAtOperand = true
+	fs.String(&o.file, "f,file", "FILE", "use FILE instead of the user's configuration")
+	fs.Footer = `Exit status:
+  0  if OK,
+  1  if KEY is not set or the file cannot be read or written,
+  2  if the command line or value is invalid.`
+	fs.Examples = []string{
+		`winux config set defaults.ls -lh`,
+		`winux config set aliases.ll "ls -la"`,
+		"winux config set commands.disabled rm,nano",
+		"winux config get ui.color",
+		"winux config list",
+	}
+	return fs
+}
+
+// ConfigFlags returns the config flag set, for help and documentation.
+func ConfigFlags() *flags.FlagSet {
+	return newConfigFlags(&configOptions{})
+}
+
+// Config implements the config command.
+// Usage: config [-f file] get|set|unset|list|path [key [value...]]
+func Config(ctx *Context, args []string) int {
+	var o configOptions
+	fs := newConfigFlags(&o)
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if len(args) == 0 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing operation"))
+	}
+
+	path := ctx.Path(o.file)
+	if o.file == "" {
+		path = config.Path(ctx.Getenv)
+	}
+
+	op, args := args[0], args[1:]
+	want := map[string]int{"get": 1, "unset": 1, "list": 0, "path": 0}
+	n, ok := want[op]
+	switch {
+	case op == "set":
+		if len(args) < 2 {
+			return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("set needs a KEY and a VALUE"))
+		}
+	case !ok:
+		return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("unknown operation '%s'", op))
+	case len(args) != n:
+		return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("wrong number of arguments for %s", op))
+	}
+
+	if op == "path" {
+		fmt.Fprintln(ctx.Stdout, path)
+		return utils.ExitSuccess
+	}
+
+	var section, name string
+	if op != "list" {
+		section, name, err = config.SplitKey(args[0])
+		if err != nil {
+			return fs.Report(ctx.Stdout, ctx.Stderr, err)
+		}
+	}
+
+	f, err := config.Open(path)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "config: %v\n", err)
+		return utils.ExitFailure
+	}
+
+	switch op {
+	case "get":
+		v, ok := f.Get(section, name)
+		if !ok {
+			return utils.ExitFailure
+		}
+		if list, isList := v.([]string); isList {
+			for _, s := range list {
+				fmt.Fprintln(ctx.Stdout, s)
+			}
+			return utils.ExitSuccess
+		}
+		fmt.Fprintln(ctx.Stdout, config.FormatPlain(v))
+		return utils.ExitSuccess
+
+	case "list":
+		for _, e := range f.Entries() {
+			fmt.Fprintf(ctx.Stdout, "%s=%s\n", e.Name(), config.FormatPlain(e.Value))
+		}
+		return utils.ExitSuccess
+
+	case "set":
+		if _, isCmd := Registry[name]; isCmd && section == "aliases" {
+			return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("'%s' is a winux command and cannot be an alias", name))
+		}
+		v, err := config.ParseValue(args[0], args[1:])
+		if err != nil {
+			return fs.Report(ctx.Stdout, ctx.Stderr, err)
+		}
+		f.Set(section, name, v)
+
+	case "unset":
+		if !f.Unset(section, name) {
+			fmt.Fprintf(ctx.Stderr, "config: '%s' is not set\n", args[0])
+			return utils.ExitFailure
+		}
+	}
+
+	if err := config.Save(path, f); err != nil {
+		fmt.Fprintf(ctx.Stderr, "config: %v\n", err)
+		return utils.ExitFailure
+	}
+	return utils.ExitSuccess
+}
+
+// configOps lists the operations of the config command.
+var configOps = []string{"get", "list", "path", "set", "unset"}
+
+// CompleteConfig completes the operation and key of winux config.
+func CompleteConfig(ctx *Context, args []string, cur string) []string {
+	switch {
+	case len(args) == 0:
+		return withPrefix(configOps, cur)
+	case len(args) > 1 || args[0] == "list" || args[0] == "path":
+		return nil
+	}
+
+	keys := append([]string(nil), config.Keys...)
+	for _, name := range commandNames() {
+		keys = append(keys, "defaults."+name)
+	}
+	for _, name := range ctx.Config.AliasNames() {
+		keys = append(keys, "aliases."+name)
+	}
+	sort.Strings(keys)
+	return withPrefix(keys, cur)
+}
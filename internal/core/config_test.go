@@ -0,0 +1,158 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/CRTYPUBG/winux/internal/config"
+)
+
+func init() {
+	Register("resolve-test", func(ctx *Context, args []string) int { return 0 })
+}
+
+// lookupContext returns a context with the given configuration and an
+// empty configuration directory and PATH, so no real plugins are seen.
+func lookupContext(t *testing.T, conf string) *Context {
+	t.Helper()
+	f, err := config.Parse("config.toml", []byte(conf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := config.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Path = "config.toml"
+	dir := t.TempDir()
+	ctx := NewContext()
+	ctx.Env = []string{
+		config.EnvPath + "=" + filepath.Join(dir, "config.toml"),
+		"APPDATA=" + dir,
+		"XDG_CONFIG_HOME=" + dir,
+		"PATH=",
+	}
+	ctx.Config = c
+	return ctx
+}
+
+func TestLookup(t *testing.T) {
+	conf := `[defaults]
+resolve-test = "--default"
+[aliases]
+rt = "resolve-test -a 'b c'"
+rt2 = "rt -d"
+loop1 = "loop2"
+loop2 = "loop1 x"
+gone = "missing-command"
+[commands]
+disabled = ["disabled-test"]
+`
+	Register("disabled-test", func(ctx *Context, args []string) int { return 0 })
+	defer delete(Registry, "disabled-test")
+
+	tests := []struct {
+		args []string
+		want []string // arguments; nil with err set
+		err  string
+	}{
+		{[]string{"resolve-test", "x"}, []string{"--default", "x"}, ""},
+		{[]string{"RESOLVE-TEST"}, []string{"--default"}, ""},
+		{[]string{"rt", "x"}, []string{"--default", "-a", "b c", "x"}, ""},
+		{[]string{"RT2"}, []string{"--default", "-a", "b c", "-d"}, ""},
+		{[]string{"loop1"}, nil, "alias 'loop1' expands too deeply; check for an alias loop"},
+		{[]string{"gone"}, nil, "'missing-command' is not a winux command. See 'winux --help'."},
+		{[]string{"nope"}, nil, "'nope' is not a winux command. See 'winux --help'."},
+		{[]string{"disabled-test"}, nil, "'disabled-test' is disabled in config.toml"},
+	}
+	for _, tt := range tests {
+		ctx := lookupContext(t, conf)
+		cmd, args, err := Lookup(ctx, tt.args)
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("Lookup(%q) error = %v, want %q", tt.args, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Lookup(%q): %v", tt.args, err)
+			continue
+		}
+		if cmd.Name != "resolve-test" || !reflect.DeepEqual(args, tt.want) {
+			t.Errorf("Lookup(%q) = %s %q, want resolve-test %q", tt.args, cmd.Name, args, tt.want)
+		}
+	}
+}
+
+func TestLookupNotFound(t *testing.T) {
+	_, _, err := Lookup(lookupContext(t, ""), []string{"nope"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("error = %v, want one matching ErrNotFound", err)
+	}
+}
+
+// writePlugin creates an executable plugin file called name in dir.
+func writePlugin(t *testing.T, ctx *Context, dir, name string) string {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, PluginPrefix+name+executableExts(ctx)[0])
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLookupPlugin(t *testing.T) {
+	ctx := lookupContext(t, `[aliases]
+hi = "hello --loud"
+[defaults]
+hello = "--quiet"
+`)
+	confDir := filepath.Join(config.Dir(ctx.Getenv), "plugins")
+	pathDir := t.TempDir()
+	ctx.Setenv("PATH", pathDir)
+
+	hello := writePlugin(t, ctx, confDir, "hello")
+	writePlugin(t, ctx, pathDir, "hello")
+	other := writePlugin(t, ctx, pathDir, "other")
+	writePlugin(t, ctx, pathDir, "resolve-test")
+
+	tests := []struct {
+		args []string
+		path string
+		want []string
+	}{
+		// The configuration directory comes before PATH.
+		{[]string{"hello", "x"}, hello, []string{"--quiet", "x"}},
+		{[]string{"hi"}, hello, []string{"--quiet", "--loud"}},
+		{[]string{"other"}, other, []string{}},
+	}
+	for _, tt := range tests {
+		cmd, args, err := Lookup(ctx, tt.args)
+		if err != nil {
+			t.Errorf("Lookup(%q): %v", tt.args, err)
+			continue
+		}
+		if cmd.Summary != tt.path || !reflect.DeepEqual(args, tt.want) {
+			t.Errorf("Lookup(%q) = %s %q, want %s %q", tt.args, cmd.Summary, args, tt.path, tt.want)
+		}
+	}
+
+	// Built-in commands hide plugins of the same name.
+	if cmd, _, err := Lookup(ctx, []string{"resolve-test"}); err != nil || cmd != Registry["resolve-test"] {
+		t.Errorf("resolve-test did not resolve to the built-in command: %v", err)
+	}
+	if got := pluginNames(ctx); !reflect.DeepEqual(got, []string{"hello", "other"}) {
+		t.Errorf("pluginNames = %q", got)
+	}
+
+	// Names with path separators are never plugins.
+	if _, ok := findPlugin(ctx, "../hello"); ok {
+		t.Error("findPlugin accepted a path")
+	}
+}
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/config"
+	"github.com/CRTYPUBG/winux/internal/fsmeta"
+	winuxio "github.com/CRTYPUBG/winux/internal/io"
+	"github.com/CRTYPUBG/winux/internal/output"
+	"github.com/CRTYPUBG/winux/internal/term"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// Context carries everything a command needs from its environment:
+// standard streams, working directory, environment variables and a
+// cancellation signal. Commands must use it instead of the os package
+// so they can be embedded, chained in-process and captured in tests.
+//
+// The embedded context.Context provides cancellation; Done and Err
+// report when the command should stop early.
+type Context struct {
+	context.Context
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// Dir is the working directory relative paths are resolved against.
+	// An empty Dir means the process working directory.
+	Dir string
+
+	// Env holds the environment in "KEY=value" form.
+	Env []string
+
+	// Config holds the user's settings; nil means none.
+	Config *config.Config
+
+	// FS is the filesystem commands read and write; nil means the
+	// operating system's. Use Files to get it.
+	FS vfs.FS
+
+	// Meta provides metadata of files in FS; nil means the operating
+	// system's. Use Metadata to get it.
+	Meta fsmeta.Provider
+
+	// Output is the format chosen with winux --output. Commands
+	// registered with Structured write JSON when it is structured.
+	Output output.Format
+}
+
+// NewContext returns a Context bound to the process streams,
+// working directory and environment.
+func NewContext() *Context {
+	dir, _ := os.Getwd()
+	return &Context{
+		Context: context.Background(),
+		Stdin:   os.Stdin,
+		Stdout:  os.Stdout,
+		Stderr:  os.Stderr,
+		Dir:     dir,
+		Env:     os.Environ(),
+	}
+}
+
+// WithContext returns a shallow copy of c using ctx for cancellation.
+func (c *Context) WithContext(ctx context.Context) *Context {
+	c2 := *c
+	c2.Context = ctx
+	return &c2
+}
+
+// Clone returns a shallow copy of c with its own environment slice,
+// suitable for changing streams or variables of a child command.
+func (c *Context) Clone() *Context {
+	c2 := *c
+	c2.Env = append([]string(nil), c.Env...)
+	return &c2
+}
+
+// Getenv returns the value of the environment variable key.
+func (c *Context) Getenv(key string) string {
+	v, _ := c.LookupEnv(key)
+	return v
+}
+
+// LookupEnv returns the value of key and whether it is set.
+// The last assignment wins, as with os/exec.
+func (c *Context) LookupEnv(key string) (string, bool) {
+	for i := len(c.Env) - 1; i >= 0; i-- {
+		k, v, ok := strings.Cut(c.Env[i], "=")
+		if ok && envKeyEqual(k, key) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// Setenv sets key to value in c.Env, replacing any existing entry.
+func (c *Context) Setenv(key, value string) {
+	c.Unsetenv(key)
+	c.Env = append(c.Env, key+"="+value)
+}
+
+// Unsetenv removes key from c.Env.
+func (c *Context) Unsetenv(key string) {
+	env := c.Env[:0]
+	for _, kv := range c.Env {
+		k, _, _ := strings.Cut(kv, "=")
+		if !envKeyEqual(k, key) {
+			env = append(env, kv)
+		}
+	}
+	c.Env = env
+}
+
+// Path resolves name against the working directory.
+// Absolute names and names used with an empty Dir are returned unchanged.
+func (c *Context) Path(name string) string {
+	if c.Dir == "" || name == "" || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return name
+	}
+	return filepath.Join(c.Dir, name)
+}
+
+// Files returns the filesystem for the command, to be used with names
+// resolved by Path.
+func (c *Context) Files() vfs.FS {
+	if c.FS == nil {
+		return vfs.OS{}
+	}
+	return c.FS
+}
+
+// Metadata returns the metadata provider for files from Files.
+func (c *Context) Metadata() fsmeta.Provider {
+	if c.Meta == nil {
+		return fsmeta.OS()
+	}
+	return c.Meta
+}
+
+// UseColor reports whether to colour output to w, given the command's
+// --color setting (term.Auto if it has none), the ui.color setting and
+// the NO_COLOR and FORCE_COLOR variables. The command runs restore
+// before returning, to undo any console mode change; see term.UseColor.
+func (c *Context) UseColor(w io.Writer, when term.When) (color bool, restore func()) {
+	var config string
+	if c.Config != nil {
+		config = c.Config.Color
+	}
+	return term.UseColor(w, when, config, c.Getenv)
+}
+
+// Width returns the number of columns to format Stdout to.
+func (c *Context) Width() int {
+	return term.Width(c.Stdout, c.Getenv)
+}
+
+// StdinPiped reports whether Stdin delivers piped or redirected input
+// rather than an interactive console.
+func (c *Context) StdinPiped() bool {
+	return winuxio.IsPipedReader(c.Stdin)
+}
+
+// cancelContext returns the context used for cancellation, treating a
+// Context built without one as never canceled.
+func (c *Context) cancelContext() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
+// Canceled reports whether the command has been asked to stop.
+func (c *Context) Canceled() bool {
+	return c.Context != nil && c.Err() != nil
+}
+
+// envKeyEqual compares environment keys; Windows treats them
+// case-insensitively.
+func envKeyEqual(a, b string) bool {
+	if filepath.Separator == '\\' {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
@@ -2,11 +2,14 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/output"
 	"github.com/CRTYPUBG/winux/internal/utils"
 )
 
@@ -14,22 +17,164 @@ import (
 var Version = "dev"
 
 // CommandFunc is the signature for all command implementations.
+// The context supplies streams, working directory and environment.
 // Returns an exit code.
-type CommandFunc func(args []string) int
+type CommandFunc func(ctx *Context, args []string) int
+
+// LegacyFunc is the pre-context command signature, reading and writing
+// the process streams directly.
+//
+// Deprecated: implement CommandFunc instead.
+type LegacyFunc func(args []string) int
+
+// Command is a registered command, its dispatch settings and the
+// metadata used to generate help and documentation.
+type Command struct {
+	Name string
+	Run  CommandFunc
+
+	Summary string                // one-line description for listings
+	Since   string                // winux version that added the command
+	Flags   func() *flags.FlagSet // synopsis, options and examples
+	Hidden  bool                  // left out of listings
+
+	// Complete suggests operands for shell completion; nil means
+	// file names.
+	Complete CompleteFunc
+
+	// NoGlob passes arguments through without wildcard expansion.
+	NoGlob bool
+
+	// NoLink leaves the command out of winux --install-links.
+	NoLink bool
+
+	// Structured means the command honours Context.Output; others
+	// refuse to run with --output=json or ndjson.
+	Structured bool
+}
+
+// Option configures a command at registration.
+type Option func(*Command)
+
+// NoGlob disables wildcard expansion for a command, for commands that
+// take scripts or patterns of their own.
+func NoGlob() Option {
+	return func(c *Command) {
+		c.NoGlob = true
+	}
+}
+
+// Structured declares that a command can write its results as JSON
+// when the context asks for it.
+func Structured() Option {
+	return func(c *Command) {
+		c.Structured = true
+	}
+}
+
+// Summary sets the one-line description shown by winux --help.
+func Summary(s string) Option {
+	return func(c *Command) {
+		c.Summary = s
+	}
+}
+
+// Since records the version that added the command.
+func Since(version string) Option {
+	return func(c *Command) {
+		c.Since = version
+	}
+}
+
+// Flags supplies the command's flag set, from which its synopsis,
+// options and examples are documented.
+func Flags(fn func() *flags.FlagSet) Option {
+	return func(c *Command) {
+		c.Flags = fn
+	}
+}
+
+// Hidden leaves the command out of help and listings.
+func Hidden() Option {
+	return func(c *Command) {
+		c.Hidden = true
+	}
+}
+
+// NoLink keeps --install-links from creating a link for a command that
+// makes no sense on its own, such as help.
+func NoLink() Option {
+	return func(c *Command) {
+		c.NoLink = true
+	}
+}
+
+// Completer sets how the command's operands are completed.
+func Completer(fn CompleteFunc) Option {
+	return func(c *Command) {
+		c.Complete = fn
+	}
+}
 
 // Registry holds all registered commands.
-var Registry = make(map[string]CommandFunc)
+var Registry = make(map[string]*Command)
 
 // Register adds a command to the registry.
-func Register(name string, fn CommandFunc) {
-	Registry[name] = fn
+func Register(name string, fn CommandFunc, opts ...Option) {
+	cmd := &Command{Name: name, Run: fn}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	Registry[name] = cmd
+}
+
+// RegisterLegacy adds a command using the old signature to the registry.
+//
+// Deprecated: implement CommandFunc and use Register.
+func RegisterLegacy(name string, fn LegacyFunc, opts ...Option) {
+	Register(name, Legacy(fn), opts...)
+}
+
+// Legacy adapts a LegacyFunc to CommandFunc. The wrapped command still
+// uses the process streams, so its output cannot be redirected.
+func Legacy(fn LegacyFunc) CommandFunc {
+	return func(ctx *Context, args []string) int {
+		return fn(args)
+	}
+}
+
+// Run executes the registered command name with ctx.
+func Run(ctx *Context, name string, args []string) int {
+	cmd, ok := Registry[name]
+	if !ok {
+		fmt.Fprintf(ctx.Stderr, "winux: '%s' is not a winux command. See 'winux --help'.\n", name)
+		return utils.ExitCommandNotFound
+	}
+	return cmd.Run(ctx, args)
 }
 
 // Dispatch resolves and executes the appropriate command.
 // Resolution order:
 //  1. Executable name (argv[0]) - BusyBox style
-//  2. First CLI argument (winux <command>)
+//  2. First CLI argument (winux <command>), then aliases from the
+//     configuration file, then winux-<command> plugins in the plugins
+//     directory or on PATH
+//
+// Commands disabled in the configuration are refused, and configured
+// default options go before the command's arguments.
+//
+// Unquoted wildcard arguments are expanded first unless the command was
+// registered with NoGlob, --no-glob precedes the command name or
+// WINUX_NOGLOB is set.
+//
+// --output=json or ndjson (--json for short) before the command name
+// asks a Structured command for JSON results.
 func Dispatch() int {
+	ctx := NewContext()
+	ctx.Config = loadConfig(ctx)
+	args := commandArgs()
+	noGlob := globDisabled(ctx)
+
 	// Try argv[0] first (BusyBox-style symlink dispatch)
 	execName := filepath.Base(os.Args[0])
 	execName = strings.TrimSuffix(execName, ".exe")
@@ -37,67 +182,105 @@ func Dispatch() int {
 
 	// If invoked as a command directly (e.g., "grep.exe" or symlink "grep")
 	if execName != "winux" {
-		if fn, ok := Registry[execName]; ok {
-			return fn(os.Args[1:])
+		if _, ok := Registry[execName]; ok {
+			args[0].value = execName
+			return runResolved(ctx, args, noGlob)
+		}
+	}
+
+	// Global options before the command name
+	args = args[1:]
+	format := ""
+options:
+	for len(args) > 0 {
+		switch v := args[0].value; {
+		case v == "--no-glob":
+			noGlob = true
+		case v == "--json":
+			format = "json"
+		case strings.HasPrefix(v, "--output="):
+			format = strings.TrimPrefix(v, "--output=")
+		case v == "--output":
+			if len(args) < 2 {
+				fmt.Fprintln(ctx.Stderr, "winux: option '--output' requires an argument")
+				return utils.ExitUsageError
+			}
+			format = args[1].value
+			args = args[1:]
+		default:
+			break options
 		}
+		args = args[1:]
+	}
+	if format != "" {
+		f, err := output.Parse(format)
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "winux: %v\n", err)
+			return utils.ExitUsageError
+		}
+		ctx.Output = f
 	}
 
 	// Otherwise, expect "winux <command> [args...]"
-	if len(os.Args) < 2 {
-		printUsage()
+	if len(args) < 1 {
+		printUsage(ctx.Stdout)
 		return utils.ExitUsageError
 	}
 
-	cmdName := strings.ToLower(os.Args[1])
+	cmdName := strings.ToLower(args[0].value)
 
 	// Handle help flags
-	if cmdName == "--help" || cmdName == "-h" || cmdName == "help" {
-		printUsage()
+	if cmdName == "--help" || cmdName == "-h" {
+		return Help(ctx, nil)
+	}
+
+	// Handle listing
+	if cmdName == "--list" {
+		printList(ctx)
 		return utils.ExitSuccess
 	}
 
+	// Handle link management
+	if cmdName == "--install-links" {
+		return InstallLinks(ctx, expandArgs(ctx, args[1:], true))
+	}
+	if cmdName == "--uninstall-links" {
+		return UninstallLinks(ctx, expandArgs(ctx, args[1:], true))
+	}
+
 	// Handle version
 	if cmdName == "--version" || cmdName == "-v" || cmdName == "version" {
-		fmt.Printf("winux v%s\n", Version)
+		fmt.Fprintf(ctx.Stdout, "winux v%s\n", Version)
 		return utils.ExitSuccess
 	}
 
-	// Look up command
-	if fn, ok := Registry[cmdName]; ok {
-		return fn(os.Args[2:])
-	}
-
-	fmt.Fprintf(os.Stderr, "winux: '%s' is not a winux command. See 'winux --help'.\n", cmdName)
-	return utils.ExitCommandNotFound
+	// Look up command or alias
+	return runResolved(ctx, args, noGlob)
 }
 
-func printUsage() {
-	fmt.Println(`WINUX - Native Linux-like utilities for Windows
-
-Usage: winux <command> [arguments]
-
-Available commands:
-  cat      Concatenate and print files
-  echo     Display a line of text
-  grep     Search for patterns in files
-  ls       List directory contents
-  mkdir    Create directories
-  nano     Edit text files
-  pwd      Print working directory
-  rm       Remove files or directories
-  touch    Create files or update timestamps
-  uptime   Display system uptime
-  whoami   Print effective username
-
-Options:
-  --help, -h       Show this help message
-  --version, -v    Show version information
-
-Examples:
-  winux ls -la
-  winux cat file.txt
-  winux grep -i error log.txt
-  winux mkdir -p path/to/dir
-  winux rm -rf temp/
-  type log.txt | winux grep error`)
+// runResolved runs the command or alias named by args[0] with the
+// configured defaults and the rest of args, expanding wildcards. An
+// unknown name gets suggestions, or is corrected if configured so.
+func runResolved(ctx *Context, args []arg, noGlob bool) int {
+	name := strings.ToLower(args[0].value)
+	cmd, resolved, err := resolve(ctx, args)
+	var nf *notFoundError
+	if errors.As(err, &nf) && nf.name == name {
+		if fixed, ok := autocorrect(ctx, name); ok {
+			args[0] = arg{value: fixed}
+			cmd, resolved, err = resolve(ctx, args)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "winux: %v\n", err)
+		if errors.As(err, &nf) {
+			printSuggestions(ctx, ctx.Stderr, nf.name)
+		}
+		return utils.ExitCommandNotFound
+	}
+	if ctx.Output.Structured() && !cmd.Structured {
+		fmt.Fprintf(ctx.Stderr, "winux: %s does not support --output=%s\n", cmd.Name, ctx.Output)
+		return utils.ExitUsageError
+	}
+	return cmd.Run(ctx, expandArgs(ctx, resolved, noGlob || cmd.NoGlob))
 }
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/glob"
+)
+
+// NoGlobEnv disables wildcard expansion in Dispatch when set to a value
+// other than "" or "0".
+const NoGlobEnv = "WINUX_NOGLOB"
+
+// arg is a command-line argument with the quoting the user typed.
+type arg struct {
+	value   string
+	pattern string // glob pattern, with quoted characters escaped
+	glob    bool   // contains unquoted wildcards
+}
+
+// commandArgs returns os.Args annotated for wildcard expansion.
+//
+// Windows programs receive their command line unexpanded, so it is
+// split again here to learn which wildcards were quoted. Elsewhere the
+// invoking shell has already expanded the arguments and none are
+// expanded again.
+func commandArgs() []arg {
+	args := make([]arg, len(os.Args))
+	for i, a := range os.Args {
+		args[i] = arg{value: a}
+	}
+
+	line, ok := commandLine()
+	if !ok {
+		return args
+	}
+	raw := splitCommandLine(line)
+	if len(raw) != len(os.Args) {
+		return args
+	}
+	for i := 1; i < len(raw); i++ {
+		if raw[i].value != os.Args[i] {
+			return args
+		}
+	}
+	return raw
+}
+
+// splitCommandLine splits a Windows command line following the same
+// rules as the Go runtime, recording which characters were quoted.
+func splitCommandLine(cmd string) []arg {
+	var args []arg
+	for len(cmd) > 0 {
+		if cmd[0] == ' ' || cmd[0] == '\t' {
+			cmd = cmd[1:]
+			continue
+		}
+		var a arg
+		cmd = a.read(cmd)
+		args = append(args, a)
+	}
+	return args
+}
+
+// read consumes the next argument from cmd and returns the remainder.
+func (a *arg) read(cmd string) string {
+	var value, pattern strings.Builder
+	add := func(c byte, quoted bool) {
+		value.WriteByte(c)
+		switch {
+		case c == '\\':
+			// A path separator, quoted or not.
+			pattern.WriteByte('/')
+		case quoted:
+			pattern.WriteString(glob.QuoteMeta(string(c)))
+		default:
+			if c == '*' || c == '?' || c == '[' {
+				a.glob = true
+			}
+			pattern.WriteByte(c)
+		}
+	}
+	slashes := func(n int, quoted bool) {
+		for ; n > 0; n-- {
+			add('\\', quoted)
+		}
+	}
+
+	inQuote := false
+	nslash := 0
+	for ; len(cmd) > 0; cmd = cmd[1:] {
+		c := cmd[0]
+		switch c {
+		case ' ', '\t':
+			if !inQuote {
+				slashes(nslash, false)
+				a.value, a.pattern = value.String(), pattern.String()
+				return cmd[1:]
+			}
+		case '"':
+			slashes(nslash/2, inQuote)
+			if nslash%2 == 0 {
+				if inQuote && len(cmd) > 1 && cmd[1] == '"' {
+					add('"', true)
+					cmd = cmd[1:]
+				}
+				inQuote = !inQuote
+			} else {
+				add('"', true)
+			}
+			nslash = 0
+			continue
+		case '\\':
+			nslash++
+			continue
+		}
+		slashes(nslash, inQuote)
+		nslash = 0
+		add(c, inQuote)
+	}
+	slashes(nslash, inQuote)
+	a.value, a.pattern = value.String(), pattern.String()
+	return ""
+}
+
+// expandArgs replaces unquoted wildcard arguments with the files they
+// match, relative to ctx.Dir. Patterns that match nothing, or are
+// malformed, are passed through unchanged as GNU tools expect.
+func expandArgs(ctx *Context, args []arg, noGlob bool) []string {
+	out := make([]string, 0, len(args))
+	for _, a := range args {
+		if noGlob || !a.glob {
+			out = append(out, a.value)
+			continue
+		}
+		matches, err := glob.Glob(ctx.Files(), ctx.Dir, a.pattern)
+		if err != nil || len(matches) == 0 {
+			out = append(out, a.value)
+			continue
+		}
+		// Answer in the separator the user typed.
+		if strings.Contains(a.value, `\`) {
+			for i, m := range matches {
+				matches[i] = filepath.FromSlash(m)
+			}
+		}
+		out = append(out, matches...)
+	}
+	return out
+}
+
+// globDisabled reports whether NoGlobEnv turns expansion off.
+func globDisabled(ctx *Context) bool {
+	v := ctx.Getenv(NoGlobEnv)
+	return v != "" && v != "0"
+}
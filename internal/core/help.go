@@ -0,0 +1,408 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// globalOptions are the options winux accepts before a command name.
+var globalOptions = [][2]string{
+	{"--help, -h", "Show this help message"},
+	{"--version, -v", "Show version information"},
+	{"--list", "List commands as NAME<TAB>TYPE<TAB>SINCE<TAB>SUMMARY"},
+	{"--no-glob", "Do not expand wildcards such as *.go (also WINUX_NOGLOB=1)"},
+	{"--output FORMAT", "Print results as json, ndjson or text (--json for short)"},
+	{"--install-links DIR", "Create ls, cat, ... commands in DIR that run winux"},
+	{"--uninstall-links DIR", "Remove the commands created by --install-links"},
+}
+
+// usageExamples are shown at the end of winux --help.
+var usageExamples = []string{
+	"winux ls -la",
+	"winux cat file.txt",
+	"winux grep -i error log.txt",
+	"winux grep -c TODO *.go",
+	"winux mkdir -p path/to/dir",
+	"winux rm -rf temp/",
+	"type log.txt | winux grep error",
+	`winux --install-links C:\Tools\winux`,
+	`winux pipe "cat log.txt | grep -i err > errors.txt"`,
+	"winux sh build.sh",
+	"winux help grep",
+}
+
+// commands returns the visible registered commands sorted by name.
+func commands() []*Command {
+	var list []*Command
+	for _, cmd := range Registry {
+		if !cmd.Hidden {
+			list = append(list, cmd)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// printUsage writes the winux overview, generated from the registry.
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "WINUX - Native Linux-like utilities for Windows")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Usage: winux [--no-glob] <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Available commands:")
+	list := commands()
+	width := 0
+	for _, cmd := range list {
+		if len(cmd.Name) > width {
+			width = len(cmd.Name)
+		}
+	}
+	for _, cmd := range list {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, cmd.Name, cmd.Summary)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Options:")
+	for _, opt := range globalOptions {
+		fmt.Fprintf(w, "  %-21s  %s\n", opt[0], opt[1])
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Examples:")
+	for _, ex := range usageExamples {
+		fmt.Fprintf(w, "  %s\n", ex)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'winux help <command>' for details on a command.")
+	fmt.Fprintln(w, "Programs named winux-<name> on PATH run as 'winux <name>'.")
+}
+
+// printList writes one tab-separated line per command for scripts:
+// name, type, the version that added it and its summary. Aliases from
+// the configuration follow with their expansion as the summary, then
+// plugins with their path.
+func printList(ctx *Context) {
+	w := ctx.Stdout
+	for _, cmd := range commands() {
+		since := cmd.Since
+		if since == "" {
+			since = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", cmd.Name, "builtin", since, cmd.Summary)
+	}
+	for _, name := range ctx.Config.AliasNames() {
+		words, _ := ctx.Config.Alias(name)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, "alias", "-", strings.Join(words, " "))
+	}
+	for _, p := range plugins(ctx) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, "plugin", "-", p.Path)
+	}
+}
+
+// helpOptions holds the parsed help flags.
+type helpOptions struct {
+	roff     bool // --roff: print a man page
+	markdown bool // --markdown: print a Markdown page
+}
+
+func newHelpFlags(o *helpOptions) *flags.FlagSet {
+	fs := flags.New("help", "[OPTION]... [COMMAND]")
+	fs.Description = `Show help for COMMAND, or the list of commands.
+With --roff or --markdown, print a manual page instead; without
+COMMAND the page describes winux itself.`
+	fs.Bool(&o.roff, "roff", "print a roff man page for man(1)")
+	fs.Bool(&o.markdown, "markdown", "print the manual page as Markdown")
+	fs.Examples = []string{
+		"winux help grep",
+		"winux help --roff ls > ls.1",
+		"winux help --markdown grep > docs/grep.md",
+	}
+	return fs
+}
+
+// HelpFlags returns the help flag set, for help and documentation.
+func HelpFlags() *flags.FlagSet {
+	return newHelpFlags(&helpOptions{})
+}
+
+// Help implements the help command.
+// Usage: help [--roff|--markdown] [command]
+func Help(ctx *Context, args []string) int {
+	var o helpOptions
+	fs := newHelpFlags(&o)
+	names, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if len(names) > 1 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("too many arguments"))
+	}
+	if o.roff && o.markdown {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("--roff and --markdown are mutually exclusive"))
+	}
+
+	if len(names) == 0 {
+		switch {
+		case o.roff:
+			writeRoffOverview(ctx.Stdout)
+		case o.markdown:
+			writeMarkdownOverview(ctx.Stdout)
+		default:
+			printUsage(ctx.Stdout)
+		}
+		return utils.ExitSuccess
+	}
+
+	name := strings.ToLower(names[0])
+	cmd, ok := Registry[name]
+	if words, isAlias := ctx.Config.Alias(name); !ok && isAlias {
+		fmt.Fprintf(ctx.Stdout, "'%s' is aliased to '%s'\n", name, strings.Join(words, " "))
+		return utils.ExitSuccess
+	}
+	if !ok && !o.roff && !o.markdown {
+		if p, isPlugin := findPlugin(ctx, name); isPlugin {
+			// Plugins document themselves.
+			return p.Command().Run(ctx, []string{"--help"})
+		}
+	}
+	if !ok {
+		fmt.Fprintf(ctx.Stderr, "winux: '%s' is not a winux command. See 'winux --help'.\n", names[0])
+		printSuggestions(ctx, ctx.Stderr, name)
+		return utils.ExitCommandNotFound
+	}
+	if cmd.Flags == nil {
+		// Undocumented commands still answer --help themselves.
+		if o.roff || o.markdown {
+			fmt.Fprintf(ctx.Stderr, "help: no documentation for '%s'\n", name)
+			return utils.ExitFailure
+		}
+		return cmd.Run(ctx, []string{"--help"})
+	}
+
+	cfs := cmd.Flags()
+	switch {
+	case o.roff:
+		writeRoff(ctx.Stdout, cmd, cfs)
+	case o.markdown:
+		writeMarkdown(ctx.Stdout, cmd, cfs)
+	default:
+		cfs.PrintHelp(ctx.Stdout)
+	}
+	return utils.ExitSuccess
+}
+
+// section is a titled block of a command's help footer, such as
+// "Exit status:" followed by indented lines.
+type section struct {
+	title string
+	lines []string
+}
+
+// footerSections splits a help footer into titled sections.
+func footerSections(footer string) []section {
+	var out []section
+	for _, block := range strings.Split(footer, "\n\n") {
+		lines := strings.Split(strings.TrimRight(block, "\n"), "\n")
+		if len(lines) == 0 || lines[0] == "" {
+			continue
+		}
+		s := section{lines: lines}
+		if strings.HasSuffix(lines[0], ":") && !strings.HasPrefix(lines[0], " ") {
+			s.title = strings.TrimSuffix(lines[0], ":")
+			s.lines = lines[1:]
+		}
+		for i, l := range s.lines {
+			s.lines[i] = strings.TrimPrefix(l, "  ")
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// roffEscape escapes text for a roff line.
+func roffEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	s = strings.ReplaceAll(s, "-", `\-`)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+	return s
+}
+
+func writeRoffLines(w io.Writer, lines []string) {
+	for _, l := range lines {
+		fmt.Fprintln(w, roffEscape(l))
+	}
+}
+
+func writeRoff(w io.Writer, cmd *Command, fs *flags.FlagSet) {
+	fmt.Fprintf(w, ".TH %s 1 \"\" \"winux %s\" \"WINUX Manual\"\n", strings.ToUpper(cmd.Name), Version)
+	fmt.Fprintln(w, ".SH NAME")
+	fmt.Fprintf(w, "%s \\- %s\n", cmd.Name, roffEscape(cmd.Summary))
+
+	fmt.Fprintln(w, ".SH SYNOPSIS")
+	for i, line := range strings.Split(fs.Usage, "\n") {
+		if i > 0 {
+			fmt.Fprintln(w, ".br")
+			line = strings.TrimPrefix(strings.TrimSpace(line), "or:")
+			line = strings.TrimPrefix(strings.TrimSpace(line), cmd.Name)
+		}
+		fmt.Fprintf(w, ".B %s\n%s\n", cmd.Name, roffEscape(strings.TrimSpace(line)))
+	}
+
+	if fs.Description != "" {
+		fmt.Fprintln(w, ".SH DESCRIPTION")
+		for i, para := range strings.Split(fs.Description, "\n\n") {
+			if i > 0 {
+				fmt.Fprintln(w, ".PP")
+			}
+			writeRoffLines(w, strings.Split(para, "\n"))
+		}
+	}
+
+	fmt.Fprintln(w, ".SH OPTIONS")
+	for _, f := range fs.Flags() {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".B %s\n", roffEscape(f.Spec()))
+		fmt.Fprintln(w, roffEscape(f.Usage))
+	}
+
+	for _, s := range footerSections(fs.Footer) {
+		if s.title != "" {
+			fmt.Fprintf(w, ".SH %s\n", strings.ToUpper(s.title))
+		}
+		fmt.Fprintln(w, ".nf")
+		writeRoffLines(w, s.lines)
+		fmt.Fprintln(w, ".fi")
+	}
+
+	if len(fs.Examples) > 0 {
+		fmt.Fprintln(w, ".SH EXAMPLES")
+		fmt.Fprintln(w, ".nf")
+		writeRoffLines(w, fs.Examples)
+		fmt.Fprintln(w, ".fi")
+	}
+
+	fmt.Fprintln(w, ".SH SEE ALSO")
+	fmt.Fprintln(w, ".BR winux (1)")
+	if cmd.Since != "" {
+		fmt.Fprintln(w, ".SH HISTORY")
+		fmt.Fprintf(w, "Added in winux %s.\n", roffEscape(cmd.Since))
+	}
+}
+
+func writeRoffOverview(w io.Writer) {
+	fmt.Fprintf(w, ".TH WINUX 1 \"\" \"winux %s\" \"WINUX Manual\"\n", Version)
+	fmt.Fprintln(w, ".SH NAME")
+	fmt.Fprintln(w, `winux \- native Linux\-like utilities for Windows`)
+	fmt.Fprintln(w, ".SH SYNOPSIS")
+	fmt.Fprintln(w, ".B winux")
+	fmt.Fprintln(w, `[\-\-no\-glob] \fICOMMAND\fR [\fIARGUMENTS\fR]`)
+	fmt.Fprintln(w, ".SH OPTIONS")
+	for _, opt := range globalOptions {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".B %s\n%s\n", roffEscape(opt[0]), roffEscape(opt[1]))
+	}
+	fmt.Fprintln(w, ".SH COMMANDS")
+	for _, cmd := range commands() {
+		fmt.Fprintln(w, ".TP")
+		fmt.Fprintf(w, ".BR %s (1)\n%s\n", cmd.Name, roffEscape(cmd.Summary))
+	}
+	fmt.Fprintln(w, ".SH EXAMPLES")
+	fmt.Fprintln(w, ".nf")
+	writeRoffLines(w, usageExamples)
+	fmt.Fprintln(w, ".fi")
+}
+
+// mdCell escapes text for a Markdown table cell, which must stay on
+// one line.
+func mdCell(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "|", `\|`), "\n", " ")
+}
+
+func writeMarkdown(w io.Writer, cmd *Command, fs *flags.FlagSet) {
+	fmt.Fprintf(w, "# %s\n\n", cmd.Name)
+	if cmd.Summary != "" {
+		fmt.Fprintf(w, "%s.\n\n", cmd.Summary)
+	}
+
+	fmt.Fprintln(w, "## Synopsis")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "```")
+	fmt.Fprintf(w, "%s %s\n", cmd.Name, fs.Usage)
+	fmt.Fprintln(w, "```")
+	fmt.Fprintln(w)
+
+	if fs.Description != "" {
+		fmt.Fprintln(w, "## Description")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, fs.Description)
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "## Options")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Option | Description |")
+	fmt.Fprintln(w, "|--------|-------------|")
+	for _, f := range fs.Flags() {
+		fmt.Fprintf(w, "| `%s` | %s |\n", f.Spec(), mdCell(f.Usage))
+	}
+	fmt.Fprintln(w)
+
+	for _, s := range footerSections(fs.Footer) {
+		if s.title != "" {
+			fmt.Fprintf(w, "## %s\n\n", s.title)
+		}
+		fmt.Fprintln(w, "```")
+		for _, l := range s.lines {
+			fmt.Fprintln(w, l)
+		}
+		fmt.Fprintln(w, "```")
+		fmt.Fprintln(w)
+	}
+
+	if len(fs.Examples) > 0 {
+		fmt.Fprintln(w, "## Examples")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "```")
+		for _, ex := range fs.Examples {
+			fmt.Fprintln(w, ex)
+		}
+		fmt.Fprintln(w, "```")
+		fmt.Fprintln(w)
+	}
+
+	if cmd.Since != "" {
+		fmt.Fprintf(w, "_Added in winux %s._\n", cmd.Since)
+	}
+}
+
+func writeMarkdownOverview(w io.Writer) {
+	fmt.Fprintln(w, "# winux")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Native Linux-like utilities for Windows.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "## Commands")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Command | Since | Description |")
+	fmt.Fprintln(w, "|---------|-------|-------------|")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "| `%s` | %s | %s |\n", cmd.Name, cmd.Since, mdCell(cmd.Summary))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "## Options")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Option | Description |")
+	fmt.Fprintln(w, "|--------|-------------|")
+	for _, opt := range globalOptions {
+		fmt.Fprintf(w, "| `%s` | %s |\n", opt[0], mdCell(opt[1]))
+	}
+}
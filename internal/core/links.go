@@ -0,0 +1,398 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// linkManifest names the file recording the links winux created in a
+// directory, so upgrades and uninstalls touch only those.
+const linkManifest = ".winux-links"
+
+// Link modes for --install-links.
+const (
+	LinkHard    = "hardlink"
+	LinkSymlink = "symlink"
+	LinkCopy    = "copy"
+	LinkShim    = "shim"
+)
+
+// linkOptions holds the parsed --install-links flags.
+type linkOptions struct {
+	mode    string // hardlink, symlink, copy or shim; "" tries each in turn
+	force   bool   // replace files winux did not create
+	dryRun  bool   // report without changing anything
+	verbose bool
+}
+
+func newLinkFlags(o *linkOptions) *flags.FlagSet {
+	fs := flags.New("winux --install-links", "[OPTION]... DIR")
+	fs.Description = `Create a command in DIR for every winux command, so that 'ls' runs
+'winux ls'. Links already created by winux are updated in place;
+links for commands that no longer exist are removed. Remove them all
+with 'winux --uninstall-links DIR'.
+
+Modes:
+  hardlink  a hard link to winux.exe (DIR must be on the same drive)
+  symlink   a symbolic link (needs Developer Mode or an admin shell)
+  copy      a full copy of winux.exe
+  shim      a small .cmd script that calls winux.exe
+Without --mode, hardlink, symlink and copy are tried in that order.`
+	fs.String(&o.mode, "m,mode", "MODE", "create links of MODE")
+	fs.Bool(&o.force, "f,force", "replace existing files winux did not create")
+	fs.Bool(&o.dryRun, "n,dry-run", "show what would be done")
+	fs.Bool(&o.verbose, "v,verbose", "print each link")
+	fs.Examples = []string{
+		`winux --install-links C:\Tools\winux`,
+		`winux --install-links --mode=shim %USERPROFILE%\bin`,
+		`winux --uninstall-links C:\Tools\winux`,
+	}
+	return fs
+}
+
+// manifest is the content of a .winux-links file.
+type manifest struct {
+	mode   string
+	target string
+	links  []string
+}
+
+func readManifest(dir string) (*manifest, error) {
+	f, err := os.Open(filepath.Join(dir, linkManifest))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := &manifest{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		key, value, _ := strings.Cut(sc.Text(), " ")
+		switch key {
+		case "mode":
+			m.mode = value
+		case "target":
+			m.target = value
+		case "link":
+			m.links = append(m.links, value)
+		}
+	}
+	return m, sc.Err()
+}
+
+func (m *manifest) write(dir string) error {
+	var b strings.Builder
+	b.WriteString("# Links created by winux --install-links. Do not edit.\n")
+	fmt.Fprintf(&b, "mode %s\n", m.mode)
+	fmt.Fprintf(&b, "target %s\n", m.target)
+	for _, l := range m.links {
+		fmt.Fprintf(&b, "link %s\n", l)
+	}
+	return os.WriteFile(filepath.Join(dir, linkManifest), []byte(b.String()), 0644)
+}
+
+func (m *manifest) has(name string) bool {
+	for _, l := range m.links {
+		if strings.EqualFold(l, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkName is the file name of the link for a command in mode.
+func linkName(cmd, mode string) string {
+	if runtime.GOOS != "windows" {
+		return cmd
+	}
+	if mode == LinkShim {
+		return cmd + ".cmd"
+	}
+	return cmd + ".exe"
+}
+
+// linkedCommands returns the names that get a link.
+func linkedCommands() []string {
+	var names []string
+	for _, cmd := range commands() {
+		if !cmd.NoLink {
+			names = append(names, cmd.Name)
+		}
+	}
+	return names
+}
+
+// InstallLinks implements winux --install-links.
+func InstallLinks(ctx *Context, args []string) int {
+	var o linkOptions
+	fs := newLinkFlags(&o)
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if len(args) != 1 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("expected one DIR argument"))
+	}
+	switch o.mode {
+	case "", LinkHard, LinkSymlink, LinkCopy, LinkShim:
+	default:
+		return fs.Report(ctx.Stdout, ctx.Stderr, fmt.Errorf("invalid mode '%s'", o.mode))
+	}
+
+	dir := ctx.Path(args[0])
+	target, err := os.Executable()
+	if err == nil {
+		target, err = filepath.EvalSymlinks(target)
+	}
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "winux: cannot locate winux executable: %v\n", err)
+		return utils.ExitFailure
+	}
+
+	if !o.dryRun {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			fmt.Fprintf(ctx.Stderr, "winux: cannot create directory '%s': %v\n", args[0], err)
+			return utils.ExitFailure
+		}
+	}
+
+	old, err := readManifest(dir)
+	if err != nil {
+		old = &manifest{}
+	}
+	if sameFile(filepath.Dir(target), dir) {
+		fmt.Fprintln(ctx.Stderr, "winux: refusing to install links into the directory of winux itself")
+		return utils.ExitFailure
+	}
+
+	// Remove everything from the previous install first: hard links and
+	// copies would otherwise keep pointing at the old binary.
+	exitCode := utils.ExitSuccess
+	for _, l := range old.links {
+		if !o.dryRun {
+			os.Remove(filepath.Join(dir, l))
+		}
+	}
+
+	m := &manifest{mode: o.mode, target: target}
+	var created []string
+	for _, name := range linkedCommands() {
+		file := linkName(name, o.mode)
+		path := filepath.Join(dir, file)
+
+		if _, err := os.Lstat(path); err == nil && !old.has(file) {
+			if !o.force {
+				fmt.Fprintf(ctx.Stderr, "winux: skipping '%s': file exists (use --force to replace)\n", path)
+				exitCode = utils.ExitFailure
+				continue
+			}
+			if !o.dryRun {
+				os.Remove(path)
+			}
+		}
+
+		if o.dryRun {
+			if o.verbose {
+				fmt.Fprintf(ctx.Stdout, "would create %s\n", path)
+			}
+			m.links = append(m.links, file)
+			continue
+		}
+
+		mode, err := createLink(target, path, name, o.mode)
+		if err != nil {
+			fmt.Fprintf(ctx.Stderr, "winux: cannot create '%s': %v\n", path, err)
+			exitCode = utils.ExitFailure
+			continue
+		}
+		if m.mode == "" {
+			m.mode = mode
+		}
+		m.links = append(m.links, file)
+		created = append(created, name)
+		if o.verbose {
+			fmt.Fprintf(ctx.Stdout, "%s: %s\n", mode, path)
+		}
+	}
+
+	if !o.dryRun {
+		if err := m.write(dir); err != nil {
+			fmt.Fprintf(ctx.Stderr, "winux: cannot write %s: %v\n", linkManifest, err)
+			return utils.ExitFailure
+		}
+		fmt.Fprintf(ctx.Stdout, "Installed %d commands in %s\n", len(created), dir)
+	}
+
+	reportShadowing(ctx, dir, linkedCommands())
+	return exitCode
+}
+
+// createLink creates path for command name. With an empty mode it tries
+// hard link, symbolic link and copy in turn, and returns the mode used.
+func createLink(target, path, name, mode string) (string, error) {
+	switch mode {
+	case LinkHard:
+		return mode, os.Link(target, path)
+	case LinkSymlink:
+		return mode, os.Symlink(target, path)
+	case LinkCopy:
+		return mode, copyFile(target, path)
+	case LinkShim:
+		return mode, writeShim(target, path, name)
+	}
+
+	if err := os.Link(target, path); err == nil {
+		return LinkHard, nil
+	}
+	if err := os.Symlink(target, path); err == nil {
+		return LinkSymlink, nil
+	}
+	return LinkCopy, copyFile(target, path)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// writeShim writes a script that runs "winux name args...".
+func writeShim(target, path, name string) error {
+	var script string
+	if runtime.GOOS == "windows" {
+		script = fmt.Sprintf("@\"%s\" %s %%*\r\n", target, name)
+	} else {
+		script = fmt.Sprintf("#!/bin/sh\nexec '%s' %s \"$@\"\n", strings.ReplaceAll(target, "'", `'\''`), name)
+	}
+	return os.WriteFile(path, []byte(script), 0755)
+}
+
+// UninstallLinks implements winux --uninstall-links.
+func UninstallLinks(ctx *Context, args []string) int {
+	fs := flags.New("winux --uninstall-links", "DIR")
+	fs.Description = "Remove the commands created by 'winux --install-links DIR'."
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+	if len(args) != 1 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("expected one DIR argument"))
+	}
+
+	dir := ctx.Path(args[0])
+	m, err := readManifest(dir)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "winux: no winux links in '%s'\n", args[0])
+		return utils.ExitFailure
+	}
+
+	exitCode := utils.ExitSuccess
+	removed := 0
+	for _, l := range m.links {
+		err := os.Remove(filepath.Join(dir, l))
+		switch {
+		case err == nil:
+			removed++
+		case !errors.Is(err, os.ErrNotExist):
+			fmt.Fprintf(ctx.Stderr, "winux: cannot remove '%s': %v\n", l, err)
+			exitCode = utils.ExitFailure
+		}
+	}
+	if exitCode == utils.ExitSuccess {
+		os.Remove(filepath.Join(dir, linkManifest))
+	}
+	fmt.Fprintf(ctx.Stdout, "Removed %d commands from %s\n", removed, dir)
+	return exitCode
+}
+
+// reportShadowing warns about executables elsewhere on PATH with the
+// same names as the links in dir: those earlier on PATH hide the winux
+// command, and those later are hidden by it.
+func reportShadowing(ctx *Context, dir string, names []string) {
+	pathDirs := filepath.SplitList(ctx.Getenv("PATH"))
+	pos := -1
+	for i, d := range pathDirs {
+		if sameFile(d, dir) {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		fmt.Fprintf(ctx.Stderr, "winux: warning: %s is not on PATH\n", dir)
+	}
+
+	exts := executableExts(ctx)
+	var before, after []string
+	for _, name := range names {
+		for i, d := range pathDirs {
+			if i == pos || d == "" {
+				continue
+			}
+			found := findExecutable(d, name, exts)
+			if found == "" {
+				continue
+			}
+			if pos < 0 || i < pos {
+				before = append(before, fmt.Sprintf("%s (by %s)", name, found))
+			} else {
+				after = append(after, fmt.Sprintf("%s (%s)", name, found))
+			}
+			break
+		}
+	}
+
+	sort.Strings(before)
+	sort.Strings(after)
+	if len(before) > 0 {
+		fmt.Fprintln(ctx.Stderr, "winux: these commands are shadowed by programs earlier on PATH:")
+		for _, s := range before {
+			fmt.Fprintf(ctx.Stderr, "  %s\n", s)
+		}
+	}
+	if len(after) > 0 {
+		fmt.Fprintln(ctx.Stderr, "winux: these commands shadow programs later on PATH:")
+		for _, s := range after {
+			fmt.Fprintf(ctx.Stderr, "  %s\n", s)
+		}
+	}
+}
+
+func findExecutable(dir, name string, exts []string) string {
+	for _, ext := range exts {
+		path := filepath.Join(dir, name+ext)
+		if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+			if runtime.GOOS == "windows" || fi.Mode()&0111 != 0 {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// sameFile reports whether a and b name the same existing file.
+func sameFile(a, b string) bool {
+	fa, errA := os.Stat(a)
+	fb, errB := os.Stat(b)
+	return errA == nil && errB == nil && os.SameFile(fa, fb)
+}
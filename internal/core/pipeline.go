@@ -0,0 +1,416 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/CRTYPUBG/winux/internal/flags"
+	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// Redirect is a single I/O redirection attached to a pipeline stage.
+type Redirect struct {
+	Fd     int    // file descriptor being redirected: 0, 1 or 2
+	Op     string // "<", ">", ">>" or ">&"
+	Target string // file name, or descriptor number for ">&"
+}
+
+// Stage is one command of a pipeline.
+type Stage struct {
+	Args   []string
+	Redirs []Redirect
+}
+
+// Pipeline is a sequence of stages connected stdout to stdin.
+type Pipeline struct {
+	Stages []Stage
+}
+
+// ParsePipeline parses a command line such as
+//
+//	cat a.txt | grep -i err > out.txt 2>&1
+//
+// Words may be quoted with '...' or "...". Outside quotes a backslash
+// only escapes operator and quote characters, so Windows paths like
+// C:\logs\a.txt need no escaping.
+func ParsePipeline(line string) (*Pipeline, error) {
+	toks, err := lexPipeline(line)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pipeline{}
+	var cur Stage
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		if !t.op {
+			cur.Args = append(cur.Args, t.text)
+			continue
+		}
+		switch t.text {
+		case "|":
+			if len(cur.Args) == 0 {
+				return nil, errors.New("syntax error near unexpected token '|'")
+			}
+			p.Stages = append(p.Stages, cur)
+			cur = Stage{}
+		case "2>&1":
+			cur.Redirs = append(cur.Redirs, Redirect{Fd: 2, Op: ">&", Target: "1"})
+		case ">&2", "1>&2":
+			cur.Redirs = append(cur.Redirs, Redirect{Fd: 1, Op: ">&", Target: "2"})
+		default:
+			if i+1 >= len(toks) || toks[i+1].op {
+				return nil, fmt.Errorf("syntax error: missing file name after '%s'", t.text)
+			}
+			r := Redirect{Fd: 1, Op: strings.TrimLeft(t.text, "012"), Target: toks[i+1].text}
+			if r.Op == "<" {
+				r.Fd = 0
+			}
+			if t.text[0] >= '0' && t.text[0] <= '2' {
+				r.Fd = int(t.text[0] - '0')
+			}
+			cur.Redirs = append(cur.Redirs, r)
+			i++
+		}
+	}
+	if len(cur.Args) == 0 {
+		if len(p.Stages) > 0 || len(cur.Redirs) > 0 {
+			return nil, errors.New("syntax error: missing command")
+		}
+		return nil, errors.New("empty pipeline")
+	}
+	p.Stages = append(p.Stages, cur)
+	return p, nil
+}
+
+type pipeToken struct {
+	text string
+	op   bool
+}
+
+// pipeOperators lists operators longest first so the lexer is greedy.
+var pipeOperators = []string{"2>&1", "1>&2", "2>>", "1>>", ">&2", "2>", "1>", ">>", "0<", "<", ">", "|"}
+
+func lexPipeline(line string) ([]pipeToken, error) {
+	var toks []pipeToken
+	var word strings.Builder
+	inWord := false
+
+	flush := func() {
+		if inWord {
+			toks = append(toks, pipeToken{text: word.String()})
+			word.Reset()
+			inWord = false
+		}
+	}
+
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			flush()
+			i++
+		case c == '\'':
+			end := strings.IndexByte(line[i+1:], c)
+			if end < 0 {
+				return nil, errors.New("unterminated ' quote")
+			}
+			word.WriteString(line[i+1 : i+1+end])
+			inWord = true
+			i += end + 2
+		case c == '"':
+			i++
+			for ; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' && i+1 < len(line) && line[i+1] == '"' {
+					i++
+				}
+				word.WriteByte(line[i])
+			}
+			if i >= len(line) {
+				return nil, errors.New(`unterminated " quote`)
+			}
+			inWord = true
+			i++
+		case c == '\\' && i+1 < len(line) && strings.IndexByte("|<>&'\" \t", line[i+1]) >= 0:
+			word.WriteByte(line[i+1])
+			inWord = true
+			i += 2
+		default:
+			op := ""
+			for _, o := range pipeOperators {
+				if strings.HasPrefix(line[i:], o) {
+					op = o
+					break
+				}
+			}
+			// A digit prefix only forms an operator at the start of a word.
+			if op != "" && (op[0] < '0' || op[0] > '9' || !inWord) {
+				flush()
+				toks = append(toks, pipeToken{text: op, op: true})
+				i += len(op)
+				continue
+			}
+			word.WriteByte(c)
+			inWord = true
+			i++
+		}
+	}
+	flush()
+	return toks, nil
+}
+
+// RunPipeline executes every stage of p concurrently inside this
+// process, connecting them with in-memory pipes. It returns the exit
+// code of the last stage, or with pipefail the exit code of the last
+// stage that failed.
+func RunPipeline(ctx *Context, p *Pipeline, pipefail bool) int {
+	codes := RunStages(ctx.cancelContext(), len(p.Stages), ctx.Stdin, ctx.Stdout, ctx.Stderr,
+		func(i int, c context.Context, stdin io.Reader, stdout, stderr io.Writer) int {
+			sctx := ctx.WithContext(c).Clone()
+			sctx.Stdin, sctx.Stdout, sctx.Stderr = stdin, stdout, stderr
+			return runStage(sctx, p.Stages[i])
+		})
+	return PipelineStatus(codes, pipefail)
+}
+
+// StageFunc runs stage i of a pipeline with the given cancellation
+// context and streams, returning its exit code.
+type StageFunc func(i int, ctx context.Context, stdin io.Reader, stdout, stderr io.Writer) int
+
+// RunStages runs n pipeline stages concurrently, each in its own
+// goroutine, connecting the output of each stage to the input of the
+// next with an in-memory pipe. The first stage reads stdin, the last
+// writes stdout and all of them share stderr. It returns the exit code
+// of every stage.
+//
+// When a stage returns, the stage feeding it is canceled and its
+// further writes fail with io.ErrClosedPipe. Once a stage has written
+// to such a closed pipe, its diagnostics are dropped, so it stops
+// quietly: the in-process equivalent of SIGPIPE.
+func RunStages(ctx context.Context, n int, stdin io.Reader, stdout, stderr io.Writer, run StageFunc) []int {
+	errw := &syncWriter{w: stderr}
+	stages := make([]*pipeStage, n)
+	for i := range stages {
+		c, cancel := context.WithCancel(ctx)
+		stages[i] = &pipeStage{ctx: c, cancel: cancel}
+	}
+
+	codes := make([]int, n)
+	var wg sync.WaitGroup
+
+	in := stdin
+	for i, s := range stages {
+		var pr *io.PipeReader
+		var pw *io.PipeWriter
+		out := stdout
+		if i < n-1 {
+			pr, pw = io.Pipe()
+			out = &stageWriter{w: pw, s: s}
+		}
+
+		wg.Add(1)
+		go func(i int, s *pipeStage, in io.Reader, out io.Writer, pw *io.PipeWriter) {
+			defer wg.Done()
+			defer s.cancel()
+			codes[i] = run(i, s.ctx, in, out, &stageStderr{w: errw, s: s})
+			if pw != nil {
+				pw.Close()
+			}
+			// Nothing reads the previous stage's output any more.
+			if i > 0 {
+				in.(*io.PipeReader).CloseWithError(io.ErrClosedPipe)
+				stages[i-1].cancel()
+			}
+		}(i, s, in, out, pw)
+
+		if pr != nil {
+			in = pr
+		}
+	}
+	wg.Wait()
+	return codes
+}
+
+// PipelineStatus returns the exit status of a pipeline whose stages
+// exited with codes: that of the last stage, or with pipefail that of
+// the last stage that failed.
+func PipelineStatus(codes []int, pipefail bool) int {
+	if pipefail {
+		for i := len(codes) - 1; i >= 0; i-- {
+			if codes[i] != utils.ExitSuccess {
+				return codes[i]
+			}
+		}
+	}
+	return codes[len(codes)-1]
+}
+
+// pipeStage is the state of one running stage of RunStages.
+type pipeStage struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	broken atomic.Bool // the stage wrote to a pipe nobody reads
+}
+
+// stageWriter is the output of a stage feeding another. Writing after
+// the reader has gone marks the stage broken and cancels it.
+type stageWriter struct {
+	w io.Writer
+	s *pipeStage
+}
+
+func (w *stageWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if errors.Is(err, io.ErrClosedPipe) {
+		w.s.broken.Store(true)
+		w.s.cancel()
+	}
+	return n, err
+}
+
+// stageStderr is the diagnostic stream of a stage. It discards writes
+// once the stage is broken, so errors caused by the closed pipe are
+// not reported.
+type stageStderr struct {
+	w io.Writer
+	s *pipeStage
+}
+
+func (w *stageStderr) Write(p []byte) (int, error) {
+	if w.s.broken.Load() {
+		return len(p), nil
+	}
+	return w.w.Write(p)
+}
+
+// runStage applies the stage's redirections and runs its command.
+func runStage(ctx *Context, st Stage) int {
+	cmd, args, err := Lookup(ctx, st.Args)
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "winux: %v\n", err)
+		return utils.ExitCommandNotFound
+	}
+
+	var files []vfs.File
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	for _, r := range st.Redirs {
+		var target interface{}
+		switch r.Op {
+		case ">&":
+			switch r.Target {
+			case "1":
+				target = ctx.Stdout
+			case "2":
+				target = ctx.Stderr
+			}
+		default:
+			flag := os.O_RDONLY
+			switch r.Op {
+			case ">":
+				flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+			case ">>":
+				flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+			}
+			f, err := ctx.Files().OpenFile(ctx.Path(r.Target), flag, 0644)
+			if err != nil {
+				fmt.Fprintf(ctx.Stderr, "winux: %s: %v\n", r.Target, err)
+				return utils.ExitFailure
+			}
+			files = append(files, f)
+			target = f
+		}
+
+		switch r.Fd {
+		case 0:
+			ctx.Stdin = target.(io.Reader)
+		case 1:
+			ctx.Stdout = target.(io.Writer)
+		case 2:
+			ctx.Stderr = target.(io.Writer)
+		}
+	}
+
+	return cmd.Run(ctx, args)
+}
+
+// syncWriter serialises writes from concurrent pipeline stages.
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
+
+// pipeOptions holds the parsed pipe flags.
+type pipeOptions struct {
+	pipefail bool // exit with the status of the last failing stage
+}
+
+func newPipeFlags(o *pipeOptions) *flags.FlagSet {
+	fs := flags.New("pipe", "[OPTION]... PIPELINE")
+	fs.Description = `Run a pipeline of winux commands inside a single process.
+Stages are separated by '|'; each may use the redirections
+  < FILE, > FILE, >> FILE, 2> FILE, 2>> FILE, 2>&1 and >&2.`
+	fs.StopAtOperand = true
+	fs.Bool(&o.pipefail, "pipefail", "exit with the status of the last failing stage")
+	fs.Func("o", "OPTION", "set OPTION; only pipefail is supported", func(v string) error {
+		if v != "pipefail" {
+			return fmt.Errorf("invalid option name '%s'", v)
+		}
+		o.pipefail = true
+		return nil
+	})
+	fs.Footer = `Exit status:
+  The exit status of the last stage, unless pipefail is set.`
+	fs.Examples = []string{
+		`winux pipe "cat a.txt | grep -i err | grep -c timeout"`,
+		`winux pipe "grep -n TODO main.go > todo.txt 2>&1"`,
+		`winux pipe --pipefail "cat missing.txt | grep x"`,
+	}
+	return fs
+}
+
+// PipeFlags returns the pipe flag set, for help and documentation.
+func PipeFlags() *flags.FlagSet {
+	return newPipeFlags(&pipeOptions{})
+}
+
+// Pipe implements the pipe command, running a pipeline of winux
+// commands inside a single process.
+// Usage: pipe [-o pipefail] "cmd [args] | cmd [args] > file"
+func Pipe(ctx *Context, args []string) int {
+	var o pipeOptions
+	fs := newPipeFlags(&o)
+	args, err := fs.Parse(args)
+	if err != nil {
+		return fs.Report(ctx.Stdout, ctx.Stderr, err)
+	}
+
+	if len(args) == 0 {
+		return fs.Report(ctx.Stdout, ctx.Stderr, errors.New("missing pipeline"))
+	}
+
+	p, err := ParsePipeline(strings.Join(args, " "))
+	if err != nil {
+		fmt.Fprintf(ctx.Stderr, "pipe: %v\n", err)
+		return utils.ExitUsageError
+	}
+
+	return RunPipeline(ctx, p, o.pipefail)
+}
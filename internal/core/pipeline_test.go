@@ -0,0 +1,121 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLexPipeline(t *testing.T) {
+	tests := []struct {
+		line string
+		want []pipeToken
+	}{
+		{"", nil},
+		{"  ls  -l\t", []pipeToken{{text: "ls"}, {text: "-l"}}},
+		{"a|b", []pipeToken{{text: "a"}, {text: "|", op: true}, {text: "b"}}},
+		{"cat 'a b' \"c d\"", []pipeToken{{text: "cat"}, {text: "a b"}, {text: "c d"}}},
+		{`echo 'it''s'`, []pipeToken{{text: "echo"}, {text: "its"}}},
+		{`echo "say \"hi\""`, []pipeToken{{text: "echo"}, {text: `say "hi"`}}},
+		{`echo "a\b"`, []pipeToken{{text: "echo"}, {text: `a\b`}}},
+		{`echo '|' "<" \| \>x \'`, []pipeToken{{text: "echo"}, {text: "|"}, {text: "<"}, {text: "|"}, {text: ">x"}, {text: "'"}}},
+		{`type C:\logs\a.txt`, []pipeToken{{text: "type"}, {text: `C:\logs\a.txt`}}},
+		{"a\\ b", []pipeToken{{text: "a b"}}},
+		{"cmd>out 2>>err <in", []pipeToken{
+			{text: "cmd"}, {text: ">", op: true}, {text: "out"},
+			{text: "2>>", op: true}, {text: "err"},
+			{text: "<", op: true}, {text: "in"},
+		}},
+		{"cmd 2>&1 >&2 1>&2", []pipeToken{
+			{text: "cmd"}, {text: "2>&1", op: true}, {text: ">&2", op: true}, {text: "1>&2", op: true},
+		}},
+		// A digit inside a word is not a descriptor.
+		{"echo a2>f", []pipeToken{{text: "echo"}, {text: "a2"}, {text: ">", op: true}, {text: "f"}}},
+		{"''", []pipeToken{{text: ""}}},
+	}
+	for _, tt := range tests {
+		got, err := lexPipeline(tt.line)
+		if err != nil {
+			t.Errorf("lexPipeline(%q): %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("lexPipeline(%q) = %+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestLexPipelineErrors(t *testing.T) {
+	for _, line := range []string{`echo 'a`, `echo "a`, `echo "a\"`} {
+		if _, err := lexPipeline(line); err == nil {
+			t.Errorf("lexPipeline(%q) succeeded, want an unterminated quote error", line)
+		}
+	}
+}
+
+func TestParsePipeline(t *testing.T) {
+	tests := []struct {
+		line string
+		want []Stage
+	}{
+		{"ls", []Stage{{Args: []string{"ls"}}}},
+		{"cat a.txt | grep -i err | sort", []Stage{
+			{Args: []string{"cat", "a.txt"}},
+			{Args: []string{"grep", "-i", "err"}},
+			{Args: []string{"sort"}},
+		}},
+		{"grep x < in.txt > out.txt 2>&1", []Stage{{
+			Args: []string{"grep", "x"},
+			Redirs: []Redirect{
+				{Fd: 0, Op: "<", Target: "in.txt"},
+				{Fd: 1, Op: ">", Target: "out.txt"},
+				{Fd: 2, Op: ">&", Target: "1"},
+			},
+		}}},
+		{"a 2> err 1>> log >&2", []Stage{{
+			Args: []string{"a"},
+			Redirs: []Redirect{
+				{Fd: 2, Op: ">", Target: "err"},
+				{Fd: 1, Op: ">>", Target: "log"},
+				{Fd: 1, Op: ">&", Target: "2"},
+			},
+		}}},
+		{"> out echo hi", []Stage{{
+			Args:   []string{"echo", "hi"},
+			Redirs: []Redirect{{Fd: 1, Op: ">", Target: "out"}},
+		}}},
+		{`echo "a | b" 'c > d'`, []Stage{{Args: []string{"echo", "a | b", "c > d"}}}},
+	}
+	for _, tt := range tests {
+		p, err := ParsePipeline(tt.line)
+		if err != nil {
+			t.Errorf("ParsePipeline(%q): %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(p.Stages, tt.want) {
+			t.Errorf("ParsePipeline(%q) = %+v, want %+v", tt.line, p.Stages, tt.want)
+		}
+	}
+}
+
+func TestParsePipelineErrors(t *testing.T) {
+	tests := []struct {
+		line, want string
+	}{
+		{"", "empty pipeline"},
+		{"   ", "empty pipeline"},
+		{"| grep x", "syntax error near unexpected token '|'"},
+		{"ls || wc", "syntax error near unexpected token '|'"},
+		{"ls |", "syntax error: missing command"},
+		{"> out", "syntax error: missing command"},
+		{"ls >", "syntax error: missing file name after '>'"},
+		{"ls > | wc", "syntax error: missing file name after '>'"},
+		{"ls 2>> < in", "syntax error: missing file name after '2>>'"},
+		{"echo 'open", "unterminated ' quote"},
+	}
+	for _, tt := range tests {
+		_, err := ParsePipeline(tt.line)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("ParsePipeline(%q) error = %v, want %q", tt.line, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/config"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// PluginPrefix starts the file name of every plugin: winux foo runs
+// winux-foo (winux-foo.exe, .cmd, ... on Windows).
+const PluginPrefix = "winux-"
+
+// PluginEnv is set for plugins to the path of the winux executable, so
+// they can run winux commands without relying on PATH.
+const PluginEnv = "WINUX"
+
+// OutputEnv is set for plugins to the format chosen with winux
+// --output, such as json, when it is not text.
+const OutputEnv = "WINUX_OUTPUT"
+
+// Plugin is an external command found on disk.
+type Plugin struct {
+	Name string // command name, without PluginPrefix or extension
+	Path string
+}
+
+// pluginDirs returns the directories searched for plugins, in order:
+// the plugins directory next to the configuration file, then PATH.
+func pluginDirs(ctx *Context) []string {
+	dirs := []string{filepath.Join(config.Dir(ctx.Getenv), "plugins")}
+	for _, d := range filepath.SplitList(ctx.Getenv("PATH")) {
+		if d != "" {
+			dirs = append(dirs, d)
+		}
+	}
+	return dirs
+}
+
+// executableExts returns the file extensions of executables: PATHEXT on
+// Windows, and none elsewhere.
+func executableExts(ctx *Context) []string {
+	if runtime.GOOS != "windows" {
+		return []string{""}
+	}
+	exts := strings.Split(strings.ToLower(ctx.Getenv("PATHEXT")), ";")
+	if len(exts) == 1 && exts[0] == "" {
+		exts = []string{".com", ".exe", ".bat", ".cmd"}
+	}
+	return exts
+}
+
+// findPlugin returns the first plugin called name in pluginDirs.
+func findPlugin(ctx *Context, name string) (Plugin, bool) {
+	if name == "" || strings.ContainsAny(name, `/\:`) {
+		return Plugin{}, false
+	}
+	exts := executableExts(ctx)
+	for _, dir := range pluginDirs(ctx) {
+		if path := findExecutable(dir, PluginPrefix+name, exts); path != "" {
+			return Plugin{Name: name, Path: path}, true
+		}
+	}
+	return Plugin{}, false
+}
+
+// plugins returns the plugins in pluginDirs sorted by name. Plugins
+// hidden by a built-in command or by an earlier one of the same name
+// are left out.
+func plugins(ctx *Context) []Plugin {
+	exts := executableExts(ctx)
+	seen := make(map[string]bool)
+	var list []Plugin
+	for _, dir := range pluginDirs(ctx) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			file := e.Name()
+			if len(file) <= len(PluginPrefix) || !strings.EqualFold(file[:len(PluginPrefix)], PluginPrefix) {
+				continue
+			}
+			name := strings.ToLower(file[len(PluginPrefix):])
+			if runtime.GOOS == "windows" {
+				ext := filepath.Ext(name)
+				if ext == "" || !contains(exts, ext) {
+					continue
+				}
+				name = strings.TrimSuffix(name, ext)
+			}
+			if _, builtin := Registry[name]; builtin || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, file)
+			fi, err := os.Stat(path)
+			if err != nil || fi.IsDir() || runtime.GOOS != "windows" && fi.Mode()&0111 == 0 {
+				continue
+			}
+			seen[name] = true
+			list = append(list, Plugin{Name: name, Path: path})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// pluginNames returns the names of the plugins.
+func pluginNames(ctx *Context) []string {
+	var names []string
+	for _, p := range plugins(ctx) {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// Command returns p as a command that runs the plugin with the
+// context's streams, directory and environment, and returns its exit
+// code.
+func (p Plugin) Command() *Command {
+	return &Command{
+		Name:       p.Name,
+		Run:        p.run,
+		Summary:    p.Path,
+		Structured: true,
+	}
+}
+
+func (p Plugin) run(ctx *Context, args []string) int {
+	cmd := exec.CommandContext(ctx.cancelContext(), p.Path, args...)
+	cmd.Args[0] = PluginPrefix + p.Name
+	cmd.Dir = ctx.Dir
+	cmd.Env = append([]string(nil), ctx.Env...)
+	if exe, err := os.Executable(); err == nil {
+		cmd.Env = append(cmd.Env, PluginEnv+"="+exe)
+	}
+	if ctx.Output.Structured() {
+		cmd.Env = append(cmd.Env, OutputEnv+"="+ctx.Output.String())
+	}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = ctx.Stdin, ctx.Stdout, ctx.Stderr
+	if err := cmd.Run(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return ee.ExitCode()
+		}
+		fmt.Fprintf(ctx.Stderr, "winux: %s: %v\n", p.Name, err)
+		return utils.ExitCannotExecute
+	}
+	return utils.ExitSuccess
+}
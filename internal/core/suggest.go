@@ -0,0 +1,103 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/CRTYPUBG/winux/internal/suggest"
+)
+
+// ErrNotFound is matched by the error Lookup returns for a name that
+// is not a command, alias or plugin.
+var ErrNotFound = errors.New("not a winux command")
+
+// notFoundError reports a name that is not a command, alias or plugin.
+type notFoundError struct {
+	name string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("'%s' is not a winux command. See 'winux --help'.", e.name)
+}
+
+func (e *notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// similarNames returns the commands, aliases and plugins close to
+// name, or the global options if name is an option.
+func similarNames(ctx *Context, name string) []string {
+	if strings.HasPrefix(name, "-") {
+		var opts []string
+		for _, opt := range globalOptions {
+			for _, o := range strings.Split(opt[0], ", ") {
+				if o = strings.Fields(o)[0]; strings.HasPrefix(o, "--") {
+					opts = append(opts, o)
+				}
+			}
+		}
+		return suggest.Similar(name, opts)
+	}
+	return suggest.Similar(name, allNames(ctx))
+}
+
+// printSuggestions lists the names closest to name, as git does.
+func printSuggestions(ctx *Context, w io.Writer, name string) {
+	var similar []string
+	for _, s := range similarNames(ctx, name) {
+		// Only the closest, and names that name starts.
+		if len(similar) == 0 || suggest.Distance(name, s) == suggest.Distance(name, similar[0]) ||
+			strings.HasPrefix(s, strings.ToLower(name)) {
+			similar = append(similar, s)
+		}
+	}
+	if len(similar) > 5 {
+		similar = similar[:5]
+	}
+	what := "command"
+	if strings.HasPrefix(name, "-") {
+		what = "option"
+	}
+	switch len(similar) {
+	case 0:
+		return
+	case 1:
+		fmt.Fprintf(w, "\nThe most similar %s is\n", what)
+	default:
+		fmt.Fprintf(w, "\nThe most similar %ss are\n", what)
+	}
+	for _, s := range similar {
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+}
+
+// autocorrect returns the command to run instead of the unknown name
+// when the help.autocorrect setting allows it and a single command is
+// closest. It warns first and waits the configured time, giving the
+// user a chance to interrupt.
+func autocorrect(ctx *Context, name string) (string, bool) {
+	delay := ctx.Config.AutocorrectDelay()
+	if delay == 0 || strings.HasPrefix(name, "-") {
+		return "", false
+	}
+	best, ok := suggest.Best(name, allNames(ctx))
+	if !ok {
+		return "", false
+	}
+
+	fmt.Fprintf(ctx.Stderr, "WARNING: You called a winux command named '%s', which does not exist.\n", name)
+	if delay < 0 {
+		fmt.Fprintf(ctx.Stderr, "Continuing under the assumption that you meant '%s'.\n", best)
+		return best, true
+	}
+	fmt.Fprintf(ctx.Stderr, "Continuing in %.1f seconds, assuming that you meant '%s'.\n", delay.Seconds(), best)
+	select {
+	case <-time.After(delay):
+		return best, true
+	case <-ctx.cancelContext().Done():
+		return "", false
+	}
+}
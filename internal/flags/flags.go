@@ -0,0 +1,428 @@
+// Package flags implements GNU getopt_long style option parsing for
+// winux commands.
+//
+// Each command declares its options once on a FlagSet and gets:
+//
+//   - bundled short options (-la) and attached arguments (-A3, -epat)
+//   - long options with --name=value or --name value
+//   - unambiguous abbreviations of long options (--ignore for --ignore-case)
+//   - "--" to end option parsing, and operands mixed with options
+//   - consistent error messages and a generated --help
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/suggest"
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// ErrHelp is returned by Parse when the help option is given.
+var ErrHelp = errors.New("help requested")
+
+// Flag is a single declared option.
+type Flag struct {
+	Names []string // short ("a") and long ("all") names, in declaration order
+	Arg   string   // argument placeholder for help, such as "NUM"; empty if none
+	Usage string
+
+	hidden   bool
+	optional bool
+	def      string
+	set      func(arg string) error
+}
+
+// OptionalArg makes the argument optional. It must then be attached,
+// as in --color=always or -A3; without one, def is used.
+func (f *Flag) OptionalArg(def string) *Flag {
+	f.optional = true
+	f.def = def
+	return f
+}
+
+// Hide leaves the flag out of the generated help.
+func (f *Flag) Hide() *Flag {
+	f.hidden = true
+	return f
+}
+
+func (f *Flag) takesArg() bool {
+	return f.Arg != ""
+}
+
+// ArgRequired reports whether the flag must be followed by an argument.
+func (f *Flag) ArgRequired() bool {
+	return f.Arg != "" && !f.optional
+}
+
+// FlagSet is the set of options of one command.
+type FlagSet struct {
+	Name        string   // command name used in messages
+	Usage       string   // synopsis after the name, such as "[OPTION]... [FILE]..."
+	Description string   // text between the synopsis and the options
+	Footer      string   // text after the options, such as the exit status
+	Examples    []string // example command lines
+
+	// Lenient treats the first unknown option as an operand instead of
+	// an error, and ends option parsing there. echo needs this.
+	Lenient bool
+
+	// StopAtOperand ends option parsing at the first operand, for
+	// commands whose operands carry options of their own.
+	StopAtOperand bool
+
+	flags []*Flag
+	help  *Flag
+}
+
+// New returns a FlagSet for the named command with a --help option.
+func New(name, usage string) *FlagSet {
+	fs := &FlagSet{Name: name, Usage: usage}
+	fs.help = fs.Func("help", "", "display this help and exit", func(string) error {
+		return ErrHelp
+	})
+	return fs
+}
+
+// Help replaces the names of the help option, for commands that also
+// accept -h.
+func (fs *FlagSet) Help(names string) {
+	fs.help.Names = splitNames(names)
+}
+
+// Func declares an option that calls fn when given. If arg is empty the
+// option takes no argument and fn receives "".
+func (fs *FlagSet) Func(names, arg, usage string, fn func(string) error) *Flag {
+	f := &Flag{Names: splitNames(names), Arg: arg, Usage: usage, set: fn}
+	fs.flags = append(fs.flags, f)
+	return f
+}
+
+// Bool declares an option that sets *p to true.
+func (fs *FlagSet) Bool(p *bool, names, usage string) *Flag {
+	return fs.Func(names, "", usage, func(string) error {
+		*p = true
+		return nil
+	})
+}
+
+// String declares an option whose argument is stored in *p.
+func (fs *FlagSet) String(p *string, names, arg, usage string) *Flag {
+	return fs.Func(names, arg, usage, func(v string) error {
+		*p = v
+		return nil
+	})
+}
+
+// Int declares an option whose argument is parsed into *p.
+func (fs *FlagSet) Int(p *int, names, arg, usage string) *Flag {
+	return fs.Func(names, arg, usage, func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid number '%s'", v)
+		}
+		*p = n
+		return nil
+	})
+}
+
+// StringList declares a repeatable option whose arguments are appended
+// to *p.
+func (fs *FlagSet) StringList(p *[]string, names, arg, usage string) *Flag {
+	return fs.Func(names, arg, usage, func(v string) error {
+		*p = append(*p, v)
+		return nil
+	})
+}
+
+func splitNames(names string) []string {
+	var out []string
+	for _, n := range strings.Split(names, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Parse parses args and returns the operands. It returns ErrHelp if the
+// help option was given.
+func (fs *FlagSet) Parse(args []string) ([]string, error) {
+	var operands []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return append(operands, args[i+1:]...), nil
+		case strings.HasPrefix(arg, "--"):
+			n, err := fs.parseLong(args, i)
+			if err == errUnknown && fs.Lenient {
+				return append(operands, args[i:]...), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			i += n
+		case len(arg) > 1 && arg[0] == '-':
+			n, err := fs.parseShort(args, i)
+			if err == errUnknown && fs.Lenient {
+				return append(operands, args[i:]...), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			i += n
+		default:
+			if fs.StopAtOperand || fs.Lenient {
+				return append(operands, args[i:]...), nil
+			}
+			operands = append(operands, arg)
+		}
+	}
+	return operands, nil
+}
+
+// errUnknown marks an unknown option so Lenient can pass it through.
+var errUnknown = errors.New("unknown option")
+
+// parseLong handles args[i], a --long option, and returns the number of
+// extra arguments consumed.
+func (fs *FlagSet) parseLong(args []string, i int) (int, error) {
+	name, value, hasValue := strings.Cut(args[i][2:], "=")
+	f, full, err := fs.lookupLong(name)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case !f.takesArg():
+		if hasValue {
+			return 0, fmt.Errorf("option '--%s' doesn't allow an argument", full)
+		}
+		return 0, f.set("")
+	case hasValue:
+		return 0, fs.setArg(f, "--"+full, value)
+	case f.optional:
+		return 0, f.set(f.def)
+	case i+1 < len(args):
+		return 1, fs.setArg(f, "--"+full, args[i+1])
+	}
+	return 0, fmt.Errorf("option '--%s' requires an argument", full)
+}
+
+// lookupLong finds a long option by exact name or unique prefix.
+func (fs *FlagSet) lookupLong(name string) (*Flag, string, error) {
+	if fs.Lenient && fs.find(name, true) == nil {
+		// Abbreviations make no sense for commands that print their
+		// arguments: echo --h must print "--h".
+		return nil, "", errUnknown
+	}
+
+	var matches []*Flag
+	var names []string
+	for _, f := range fs.flags {
+		for _, n := range f.Names {
+			if len(n) < 2 {
+				continue
+			}
+			if n == name {
+				return f, n, nil
+			}
+			if strings.HasPrefix(n, name) && name != "" {
+				matches = append(matches, f)
+				names = append(names, n)
+			}
+		}
+	}
+
+	switch {
+	case len(matches) == 1:
+		return matches[0], names[0], nil
+	case len(matches) > 1 && !sameFlag(matches):
+		sort.Strings(names)
+		return nil, "", fmt.Errorf("option '--%s' is ambiguous; possibilities: '--%s'", name, strings.Join(names, "' '--"))
+	case len(matches) > 1:
+		return matches[0], names[0], nil
+	}
+	return nil, "", fmt.Errorf("unrecognized option '--%s'%s", name, fs.hint(name))
+}
+
+// hint suggests the long options closest to the mistyped name, as in
+// "\nDid you mean '--color'?", or returns "".
+func (fs *FlagSet) hint(name string) string {
+	var names []string
+	for _, f := range fs.Flags() {
+		for _, n := range f.Names {
+			if len(n) > 1 {
+				names = append(names, n)
+			}
+		}
+	}
+	similar := suggest.Similar(name, names)
+	if len(similar) == 0 {
+		return ""
+	}
+	if len(similar) > 3 {
+		similar = similar[:3]
+	}
+	return "\nDid you mean '--" + strings.Join(similar, "' or '--") + "'?"
+}
+
+func sameFlag(fl []*Flag) bool {
+	for _, f := range fl[1:] {
+		if f != fl[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseShort handles args[i], a bundle of short options, and returns
+// the number of extra arguments consumed.
+func (fs *FlagSet) parseShort(args []string, i int) (int, error) {
+	arg := args[i]
+
+	if fs.Lenient {
+		// All or nothing: "-nx" is printed as-is by echo.
+		for _, c := range arg[1:] {
+			f := fs.find(string(c), false)
+			if f == nil || f.takesArg() {
+				return 0, errUnknown
+			}
+		}
+	}
+
+	for j := 1; j < len(arg); j++ {
+		c := string(arg[j])
+		f := fs.find(c, false)
+		if f == nil {
+			return 0, fmt.Errorf("invalid option -- '%s'", c)
+		}
+		if !f.takesArg() {
+			if err := f.set(""); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		// The rest of the bundle, or the next argument, is the value.
+		if rest := arg[j+1:]; rest != "" {
+			return 0, fs.setArg(f, "-"+c, rest)
+		}
+		if f.optional {
+			return 0, f.set(f.def)
+		}
+		if i+1 < len(args) {
+			return 1, fs.setArg(f, "-"+c, args[i+1])
+		}
+		return 0, fmt.Errorf("option requires an argument -- '%s'", c)
+	}
+	return 0, nil
+}
+
+func (fs *FlagSet) setArg(f *Flag, spelled, value string) error {
+	if err := f.set(value); err != nil {
+		if err == ErrHelp {
+			return err
+		}
+		return fmt.Errorf("%v for '%s'", err, spelled)
+	}
+	return nil
+}
+
+// find returns the flag with the given short or long name.
+func (fs *FlagSet) find(name string, long bool) *Flag {
+	for _, f := range fs.flags {
+		for _, n := range f.Names {
+			if n == name && (len(n) > 1) == long {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// Report handles an error from Parse: it prints the help for ErrHelp
+// and returns ExitSuccess, and otherwise prints the error with a hint
+// to stderr and returns ExitUsageError.
+func (fs *FlagSet) Report(stdout, stderr io.Writer, err error) int {
+	if err == ErrHelp {
+		fs.PrintHelp(stdout)
+		return utils.ExitSuccess
+	}
+	fmt.Fprintf(stderr, "%s: %v\n", fs.Name, err)
+	fmt.Fprintf(stderr, "Try '%s --help' for more information.\n", fs.Name)
+	return utils.ExitUsageError
+}
+
+// PrintHelp writes the generated help text to w.
+func (fs *FlagSet) PrintHelp(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s %s\n", fs.Name, fs.Usage)
+	if fs.Description != "" {
+		fmt.Fprintf(w, "\n%s\n", fs.Description)
+	}
+
+	list := fs.Flags()
+	width := 0
+	for _, f := range list {
+		if n := len(f.Spec()); n > width {
+			width = n
+		}
+	}
+	// Continuation lines of a usage line up with its first line.
+	indent := "\n" + strings.Repeat(" ", width+4)
+	fmt.Fprintln(w, "\nOptions:")
+	for _, f := range list {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, f.Spec(), strings.ReplaceAll(f.Usage, "\n", indent))
+	}
+
+	if fs.Footer != "" {
+		fmt.Fprintf(w, "\n%s\n", fs.Footer)
+	}
+	if len(fs.Examples) > 0 {
+		fmt.Fprintln(w, "\nExamples:")
+		for _, ex := range fs.Examples {
+			fmt.Fprintf(w, "  %s\n", ex)
+		}
+	}
+}
+
+// Flags returns the visible flags in declaration order, with the help
+// option last.
+func (fs *FlagSet) Flags() []*Flag {
+	var out []*Flag
+	for _, f := range fs.flags {
+		if !f.hidden && f != fs.help {
+			out = append(out, f)
+		}
+	}
+	return append(out, fs.help)
+}
+
+// Spec formats the names of f as in "-A, --after-context=NUM".
+func (f *Flag) Spec() string {
+	var parts []string
+	for _, n := range f.Names {
+		if len(n) == 1 {
+			parts = append(parts, "-"+n)
+		} else {
+			parts = append(parts, "--"+n)
+		}
+	}
+	spec := strings.Join(parts, ", ")
+	switch {
+	case !f.takesArg():
+	case f.optional:
+		spec += "[=" + f.Arg + "]"
+	case len(f.Names[len(f.Names)-1]) > 1:
+		spec += "=" + f.Arg
+	default:
+		spec += " " + f.Arg
+	}
+	return spec
+}
@@ -0,0 +1,245 @@
+package flags
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testOptions are the options of the grep-like command used by the tests.
+type testOptions struct {
+	all, long, ignoreCase, ignoreBlank bool
+	after                              int
+	color                              string
+	patterns                           []string
+}
+
+func newTestFlags(o *testOptions) *FlagSet {
+	fs := New("test", "[OPTION]... [FILE]...")
+	fs.Bool(&o.all, "a,all", "all")
+	fs.Bool(&o.long, "l", "long")
+	fs.Bool(&o.ignoreCase, "i,ignore-case", "ignore case")
+	fs.Bool(&o.ignoreBlank, "ignore-blank-lines", "ignore blank lines")
+	fs.Int(&o.after, "A,after-context", "NUM", "lines after")
+	fs.String(&o.color, "color,colour", "WHEN", "colour").OptionalArg("auto")
+	fs.StringList(&o.patterns, "e,regexp", "PATTERNS", "patterns")
+	return fs
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args     []string
+		want     testOptions
+		operands []string
+	}{
+		{nil, testOptions{}, nil},
+		{[]string{"x", "y"}, testOptions{}, []string{"x", "y"}},
+
+		// Short options, alone and clustered.
+		{[]string{"-a", "-l"}, testOptions{all: true, long: true}, nil},
+		{[]string{"-la"}, testOptions{all: true, long: true}, nil},
+		{[]string{"-lai", "f"}, testOptions{all: true, long: true, ignoreCase: true}, []string{"f"}},
+
+		// Attached and detached values.
+		{[]string{"-A3"}, testOptions{after: 3}, nil},
+		{[]string{"-A", "3"}, testOptions{after: 3}, nil},
+		{[]string{"-laA2", "f"}, testOptions{all: true, long: true, after: 2}, []string{"f"}},
+		{[]string{"-lA", "2", "f"}, testOptions{long: true, after: 2}, []string{"f"}},
+		{[]string{"-e-x"}, testOptions{patterns: []string{"-x"}}, nil},
+		{[]string{"-e", "-x", "-e", "y"}, testOptions{patterns: []string{"-x", "y"}}, nil},
+		{[]string{"--after-context=4"}, testOptions{after: 4}, nil},
+		{[]string{"--after-context", "4"}, testOptions{after: 4}, nil},
+		{[]string{"--regexp="}, testOptions{patterns: []string{""}}, nil},
+
+		// Optional arguments must be attached.
+		{[]string{"--color"}, testOptions{color: "auto"}, nil},
+		{[]string{"--color=never"}, testOptions{color: "never"}, nil},
+		{[]string{"--color", "never"}, testOptions{color: "auto"}, []string{"never"}},
+		{[]string{"--colour=always"}, testOptions{color: "always"}, nil},
+
+		// Operands mixed with options, and "--".
+		{[]string{"a", "-l", "b"}, testOptions{long: true}, []string{"a", "b"}},
+		{[]string{"-l", "--", "-a", "--all"}, testOptions{long: true}, []string{"-a", "--all"}},
+		{[]string{"--", "--"}, testOptions{}, []string{"--"}},
+		{[]string{"-"}, testOptions{}, []string{"-"}},
+	}
+	for _, tt := range tests {
+		var o testOptions
+		operands, err := newTestFlags(&o).Parse(tt.args)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(o, tt.want) {
+			t.Errorf("Parse(%q) options = %+v, want %+v", tt.args, o, tt.want)
+		}
+		if !reflect.DeepEqual(operands, tt.operands) {
+			t.Errorf("Parse(%q) operands = %q, want %q", tt.args, operands, tt.operands)
+		}
+	}
+}
+
+func TestParseAbbreviations(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want testOptions
+	}{
+		{"--al", testOptions{all: true}},
+		{"--ignore-c", testOptions{ignoreCase: true}},
+		{"--ignore-b", testOptions{ignoreBlank: true}},
+		{"--after=5", testOptions{after: 5}},
+		// Both prefixes name the same flag, so they are not ambiguous.
+		{"--col=never", testOptions{color: "never"}},
+		{"--reg=x", testOptions{patterns: []string{"x"}}},
+	}
+	for _, tt := range tests {
+		var o testOptions
+		if _, err := newTestFlags(&o).Parse([]string{tt.arg}); err != nil {
+			t.Errorf("Parse(%q): %v", tt.arg, err)
+			continue
+		}
+		if !reflect.DeepEqual(o, tt.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.arg, o, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-x"}, "invalid option -- 'x'"},
+		{[]string{"-lx"}, "invalid option -- 'x'"},
+		{[]string{"-A"}, "option requires an argument -- 'A'"},
+		{[]string{"-Afoo"}, "invalid number 'foo' for '-A'"},
+		{[]string{"-A", "--", "x"}, "invalid number '--' for '-A'"}, // "--" is the value
+		{[]string{"--after-context"}, "option '--after-context' requires an argument"},
+		{[]string{"--after-context=x"}, "invalid number 'x' for '--after-context'"},
+		{[]string{"--all=yes"}, "option '--all' doesn't allow an argument"},
+		{[]string{"--ignore"}, "option '--ignore' is ambiguous; possibilities: '--ignore-blank-lines' '--ignore-case'"},
+		{[]string{"--"}, ""},
+		{[]string{"--nope"}, "unrecognized option '--nope'"},
+		{[]string{"--colr"}, "unrecognized option '--colr'\nDid you mean '--color' or '--colour'?"},
+		{[]string{"--ignore-cas=1"}, "option '--ignore-case' doesn't allow an argument"},
+	}
+	for _, tt := range tests {
+		var o testOptions
+		_, err := newTestFlags(&o).Parse(tt.args)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("Parse(%q): %v", tt.args, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestParseHelp(t *testing.T) {
+	for _, args := range [][]string{{"--help"}, {"--he"}, {"x", "--help", "-Z"}} {
+		var o testOptions
+		if _, err := newTestFlags(&o).Parse(args); err != ErrHelp {
+			t.Errorf("Parse(%q) error = %v, want ErrHelp", args, err)
+		}
+	}
+
+	var o testOptions
+	fs := newTestFlags(&o)
+	fs.Help("h,help")
+	if _, err := fs.Parse([]string{"-lh"}); err != ErrHelp {
+		t.Errorf("Parse(-lh) error = %v, want ErrHelp", err)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	tests := []struct {
+		args, operands []string
+		n, e           bool
+	}{
+		{[]string{"-n", "x"}, []string{"x"}, true, false},
+		{[]string{"-ne", "x"}, []string{"x"}, true, true},
+		{[]string{"-nx", "y"}, []string{"-nx", "y"}, false, false},
+		{[]string{"--h"}, []string{"--h"}, false, false},
+		{[]string{"x", "-n"}, []string{"x", "-n"}, false, false},
+		{[]string{"-n", "--", "x"}, []string{"x"}, true, false},
+	}
+	for _, tt := range tests {
+		var n, e bool
+		fs := New("echo", "[STRING]...")
+		fs.Lenient = true
+		fs.Bool(&n, "n", "no newline")
+		fs.Bool(&e, "e", "escapes")
+		operands, err := fs.Parse(tt.args)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(operands, tt.operands) || n != tt.n || e != tt.e {
+			t.Errorf("Parse(%q) = %q, -n %v, -e %v; want %q, %v, %v", tt.args, operands, n, e, tt.operands, tt.n, tt.e)
+		}
+	}
+}
+
+func TestStopAtOperand(t *testing.T) {
+	var l bool
+	fs := New("xargs", "[OPTION]... COMMAND [ARG]...")
+	fs.StopAtOperand = true
+	fs.Bool(&l, "l", "long")
+	operands, err := fs.Parse([]string{"-l", "ls", "-l"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l || !reflect.DeepEqual(operands, []string{"ls", "-l"}) {
+		t.Errorf("got -l %v, operands %q", l, operands)
+	}
+}
+
+func TestReport(t *testing.T) {
+	var o testOptions
+	fs := newTestFlags(&o)
+	_, err := fs.Parse([]string{"-x"})
+
+	var stdout, stderr bytes.Buffer
+	if code := fs.Report(&stdout, &stderr, err); code != 2 {
+		t.Errorf("Report returned %d, want 2", code)
+	}
+	want := "test: invalid option -- 'x'\nTry 'test --help' for more information.\n"
+	if stderr.String() != want || stdout.Len() != 0 {
+		t.Errorf("Report wrote %q to stderr and %q to stdout, want %q", stderr.String(), stdout.String(), want)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := fs.Report(&stdout, &stderr, ErrHelp); code != 0 {
+		t.Errorf("Report(ErrHelp) returned %d, want 0", code)
+	}
+	if !strings.HasPrefix(stdout.String(), "Usage: test [OPTION]... [FILE]...\n") || stderr.Len() != 0 {
+		t.Errorf("Report(ErrHelp) wrote %q", stdout.String())
+	}
+}
+
+func TestSpec(t *testing.T) {
+	var o testOptions
+	fs := newTestFlags(&o)
+	var got []string
+	for _, f := range fs.Flags() {
+		got = append(got, f.Spec())
+	}
+	want := []string{
+		"-a, --all",
+		"-l",
+		"-i, --ignore-case",
+		"--ignore-blank-lines",
+		"-A, --after-context=NUM",
+		"--color, --colour[=WHEN]",
+		"-e, --regexp=PATTERNS",
+		"--help",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("specs = %q, want %q", got, want)
+	}
+}
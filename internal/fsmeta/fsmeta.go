@@ -0,0 +1,93 @@
+// Package fsmeta reads file metadata that fs.FileInfo does not carry
+// portably: link counts, file IDs, owners and groups, and Windows file
+// attributes.
+//
+// Commands get a Provider from core.Context.Metadata, so tests can
+// substitute fixed metadata for files in a vfs.Mem.
+package fsmeta
+
+import (
+	"io/fs"
+	"sync"
+)
+
+// Attributes are Windows file attributes.
+type Attributes uint32
+
+const (
+	ReadOnly Attributes = 1 << iota
+	Hidden
+	System
+	Archive
+	ReparsePoint // a symbolic link, junction or other reparse point
+	Junction     // a reparse point mounting a directory
+)
+
+// String returns the attributes in the style of the PowerShell Mode
+// column, without its leading d: five letters "arhsl" with "-" for
+// attributes that are not set.
+func (a Attributes) String() string {
+	b := []byte("-----")
+	for i, bit := range []Attributes{Archive, ReadOnly, Hidden, System, ReparsePoint} {
+		if a&bit != 0 {
+			b[i] = "arhsl"[i]
+		}
+	}
+	return string(b)
+}
+
+// Meta is the metadata of a file. Fields the platform does not provide
+// are zero.
+type Meta struct {
+	Links uint64 // hard links
+	ID    uint64 // inode number, or NTFS file index on Windows
+
+	// UID and GID are the numeric user and group IDs, or security
+	// identifiers (SIDs) on Windows. Owner and Group are their account
+	// names.
+	UID, GID     string
+	Owner, Group string
+
+	Attrs Attributes
+}
+
+// A Provider looks up metadata.
+type Provider interface {
+	// Lookup returns the metadata of the file at path, which info
+	// describes as returned by Stat or Lstat.
+	Lookup(path string, info fs.FileInfo) Meta
+
+	// Attributes returns just Meta.Attrs, which is cheaper.
+	Attributes(path string, info fs.FileInfo) Attributes
+}
+
+// OS returns the Provider for files of the operating system, as
+// reached through vfs.OS. Files from other sources get empty metadata.
+func OS() Provider {
+	return system
+}
+
+var system = &systemProvider{}
+
+// systemProvider caches account names, which take a system call or a
+// file read to look up.
+type systemProvider struct {
+	mu    sync.Mutex
+	names map[string]string // by UID or GID, with a "u" or "g" prefix
+}
+
+// name returns the cached name for key, calling lookup on a miss. An
+// unknown account is cached as "".
+func (p *systemProvider) name(key string, lookup func() string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n, ok := p.names[key]; ok {
+		return n
+	}
+	if p.names == nil {
+		p.names = make(map[string]string)
+	}
+	n := lookup()
+	p.names[key] = n
+	return n
+}
@@ -0,0 +1,41 @@
+//go:build !windows
+
+package fsmeta
+
+import (
+	"io/fs"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+func (p *systemProvider) Lookup(path string, info fs.FileInfo) Meta {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return Meta{}
+	}
+	m := Meta{
+		Links: uint64(st.Nlink),
+		ID:    uint64(st.Ino),
+		UID:   strconv.FormatUint(uint64(st.Uid), 10),
+		GID:   strconv.FormatUint(uint64(st.Gid), 10),
+	}
+	m.Owner = p.name("u"+m.UID, func() string {
+		if u, err := user.LookupId(m.UID); err == nil {
+			return u.Username
+		}
+		return ""
+	})
+	m.Group = p.name("g"+m.GID, func() string {
+		if g, err := user.LookupGroupId(m.GID); err == nil {
+			return g.Name
+		}
+		return ""
+	})
+	return m
+}
+
+// Attributes returns 0: files here have none of the Windows attributes.
+func (p *systemProvider) Attributes(path string, info fs.FileInfo) Attributes {
+	return 0
+}
@@ -0,0 +1,117 @@
+package fsmeta
+
+import (
+	"io/fs"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	advapi32                 = syscall.NewLazyDLL("advapi32.dll")
+	procGetNamedSecurityInfo = advapi32.NewProc("GetNamedSecurityInfoW")
+)
+
+const (
+	seFileObject             = 1
+	ownerSecurityInformation = 0x1
+	groupSecurityInformation = 0x2
+	fileAttributeArchive     = 0x20
+	fileAttributeSystem      = 0x4
+	ioReparseTagMountPoint   = 0xA0000003
+)
+
+func (p *systemProvider) Lookup(path string, info fs.FileInfo) Meta {
+	if _, ok := info.Sys().(*syscall.Win32FileAttributeData); !ok {
+		return Meta{}
+	}
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return Meta{}
+	}
+	m := Meta{Attrs: p.Attributes(path, info)}
+
+	// Backup semantics allow opening directories.
+	h, err := syscall.CreateFile(name, 0, syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE|syscall.FILE_SHARE_DELETE,
+		nil, syscall.OPEN_EXISTING, syscall.FILE_FLAG_BACKUP_SEMANTICS|syscall.FILE_FLAG_OPEN_REPARSE_POINT, 0)
+	if err == nil {
+		var fi syscall.ByHandleFileInformation
+		if syscall.GetFileInformationByHandle(h, &fi) == nil {
+			m.Links = uint64(fi.NumberOfLinks)
+			m.ID = uint64(fi.FileIndexHigh)<<32 | uint64(fi.FileIndexLow)
+		}
+		syscall.CloseHandle(h)
+	}
+
+	var owner, group *syscall.SID
+	var sd uintptr
+	r, _, _ := procGetNamedSecurityInfo.Call(uintptr(unsafe.Pointer(name)), seFileObject,
+		ownerSecurityInformation|groupSecurityInformation,
+		uintptr(unsafe.Pointer(&owner)), uintptr(unsafe.Pointer(&group)), 0, 0, uintptr(unsafe.Pointer(&sd)))
+	if r == 0 {
+		// The SIDs point into the security descriptor.
+		m.UID, m.Owner = p.account(owner)
+		m.GID, m.Group = p.account(group)
+		syscall.LocalFree(syscall.Handle(sd))
+	}
+	return m
+}
+
+// account returns the string form of sid and its account name.
+func (p *systemProvider) account(sid *syscall.SID) (id, name string) {
+	if sid == nil {
+		return "", ""
+	}
+	id, err := sid.String()
+	if err != nil {
+		return "", ""
+	}
+	return id, p.name(id, func() string {
+		account, _, _, err := sid.LookupAccount("")
+		if err != nil {
+			return ""
+		}
+		return account
+	})
+}
+
+func (p *systemProvider) Attributes(path string, info fs.FileInfo) Attributes {
+	d, ok := info.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return 0
+	}
+	a := attributes(d.FileAttributes)
+	if a&ReparsePoint != 0 {
+		if name, err := syscall.UTF16PtrFromString(path); err == nil && isJunction(name) {
+			a |= Junction
+		}
+	}
+	return a
+}
+
+func attributes(fa uint32) Attributes {
+	var a Attributes
+	for bit, attr := range map[uint32]Attributes{
+		syscall.FILE_ATTRIBUTE_READONLY:      ReadOnly,
+		syscall.FILE_ATTRIBUTE_HIDDEN:        Hidden,
+		fileAttributeSystem:                  System,
+		fileAttributeArchive:                 Archive,
+		syscall.FILE_ATTRIBUTE_REPARSE_POINT: ReparsePoint,
+	} {
+		if fa&bit != 0 {
+			a |= attr
+		}
+	}
+	return a
+}
+
+// isJunction reports whether the reparse point name is a junction.
+// Only the directory listing carries the reparse tag.
+func isJunction(name *uint16) bool {
+	var fd syscall.Win32finddata
+	h, err := syscall.FindFirstFile(name, &fd)
+	if err != nil {
+		return false
+	}
+	syscall.FindClose(h)
+	return fd.Reserved0 == ioReparseTagMountPoint
+}
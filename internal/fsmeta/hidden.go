@@ -0,0 +1,62 @@
+package fsmeta
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// HiddenEnv is the environment variable choosing the Hider: dotfiles,
+// attributes or both.
+const HiddenEnv = "WINUX_HIDDEN"
+
+// A Hider decides which files listings leave out unless all are asked
+// for, as with ls -a.
+type Hider interface {
+	Hidden(name string, attrs Attributes) bool
+}
+
+// HiderFunc adapts a function to a Hider.
+type HiderFunc func(name string, attrs Attributes) bool
+
+func (f HiderFunc) Hidden(name string, attrs Attributes) bool {
+	return f(name, attrs)
+}
+
+var (
+	// Dotfiles hides names starting with ".", the Unix convention.
+	Dotfiles Hider = HiderFunc(func(name string, _ Attributes) bool {
+		return strings.HasPrefix(name, ".")
+	})
+
+	// HiddenAttribute hides files with the hidden attribute, as
+	// Explorer and dir do.
+	HiddenAttribute Hider = HiderFunc(func(_ string, attrs Attributes) bool {
+		return attrs&Hidden != 0
+	})
+
+	// Both hides files that either of the others hides.
+	Both Hider = HiderFunc(func(name string, attrs Attributes) bool {
+		return Dotfiles.Hidden(name, attrs) || HiddenAttribute.Hidden(name, attrs)
+	})
+)
+
+// ParseHider returns the Hider named by a HiddenEnv value, or the
+// platform's default for "": HiddenAttribute on Windows and Dotfiles
+// elsewhere.
+func ParseHider(s string) (Hider, error) {
+	switch s {
+	case "":
+		if runtime.GOOS == "windows" {
+			return HiddenAttribute, nil
+		}
+		return Dotfiles, nil
+	case "dotfiles":
+		return Dotfiles, nil
+	case "attributes":
+		return HiddenAttribute, nil
+	case "both":
+		return Both, nil
+	}
+	return nil, fmt.Errorf("invalid %s value '%s' (valid values are 'dotfiles', 'attributes' and 'both')", HiddenEnv, s)
+}
@@ -0,0 +1,164 @@
+// Package gitignore matches paths against the patterns of .gitignore
+// files, so that searches can skip what git would not track.
+//
+// Patterns follow gitignore(5): blank lines and lines starting with #
+// are ignored, ! negates a pattern, a trailing / matches directories
+// only, a pattern containing another / is relative to the directory of
+// its .gitignore and otherwise matches a name at any depth below it,
+// and * , ?, [...] and ** match as git does. The last matching pattern
+// wins.
+package gitignore
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// FileName is the name of the files holding patterns.
+const FileName = ".gitignore"
+
+// Ignore is a set of patterns gathered from .gitignore files in a tree.
+// The zero value ignores nothing. Add returns a new Ignore, so a walk
+// can keep one per directory without copying.
+type Ignore struct {
+	rules []rule
+}
+
+type rule struct {
+	base    string // directory of the .gitignore, "" for the root
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// Add returns ig extended with the patterns in data, read from the
+// .gitignore in the directory dir, a slash path relative to the root
+// of the tree ("" for the root itself). Invalid patterns are skipped.
+func (ig *Ignore) Add(dir string, data []byte) *Ignore {
+	var rules []rule
+	if ig != nil {
+		rules = ig.rules[:len(ig.rules):len(ig.rules)]
+	}
+	dir = strings.Trim(dir, "/")
+	if dir == "." {
+		dir = ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if r, ok := parse(strings.TrimSuffix(line, "\r"), dir); ok {
+			rules = append(rules, r)
+		}
+	}
+	return &Ignore{rules: rules}
+}
+
+// Match reports whether the file at name, a slash path relative to the
+// root of the tree, is ignored.
+func (ig *Ignore) Match(name string, isDir bool) bool {
+	if ig == nil {
+		return false
+	}
+	name = strings.Trim(name, "/")
+	ignored := false
+	for _, r := range ig.rules {
+		rel := name
+		if r.base != "" {
+			if !strings.HasPrefix(name, r.base+"/") {
+				continue
+			}
+			rel = name[len(r.base)+1:]
+		}
+		m := r.re.FindStringSubmatch(rel)
+		// Directory patterns match a file only through a directory
+		// holding it.
+		if m != nil && (!r.dirOnly || isDir || m[1] != "") {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+func parse(line, dir string) (rule, bool) {
+	// Trailing spaces are ignored unless escaped.
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+	if line == "" || strings.HasPrefix(line, "#") {
+		return rule{}, false
+	}
+	r := rule{base: dir}
+	if strings.HasPrefix(line, "!") {
+		r.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		r.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return rule{}, false
+	}
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '*' && strings.HasPrefix(line[i:], "**") && (i == 0 || line[i-1] == '/'):
+			rest := line[i+2:]
+			switch {
+			case rest == "":
+				b.WriteString(".*")
+			case rest[0] == '/':
+				b.WriteString("(?:.*/)?")
+				i++
+			default:
+				b.WriteString("[^/]*")
+			}
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(line[i+1:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := line[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(line):
+			i++
+			b.WriteString(regexp.QuoteMeta(line[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	// A pattern matching a directory also covers everything in it.
+	b.WriteString("(/.*)?$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return rule{}, false
+	}
+	r.re = re
+	return r, true
+}
+
+// Join returns the slash path of name in the directory dir, both
+// relative to the root of a tree.
+func Join(dir, name string) string {
+	if dir == "" || dir == "." {
+		return name
+	}
+	return path.Join(dir, name)
+}
@@ -0,0 +1,355 @@
+// Package glob implements shell-style filename pattern matching and
+// expansion shared by the shell and the dispatcher.
+//
+// Patterns use '/' as the path separator and support:
+//
+//	'*'    any sequence of characters except '/'
+//	'?'    any single character except '/'
+//	[...]  a character class; [!...] or [^...] negates it
+//	\c     the literal character c
+//	**     as a whole component, zero or more directories
+//
+// A leading '.' in a name must be matched explicitly, as in POSIX shells.
+// On Windows, file names match case-insensitively, as in cmd and
+// PowerShell.
+package glob
+
+import (
+	"errors"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// foldCase makes file name matching ignore case, as the file system does.
+var foldCase = runtime.GOOS == "windows"
+
+// ErrBadPattern indicates a malformed pattern, such as an unclosed class.
+var ErrBadPattern = errors.New("syntax error in pattern")
+
+// HasMeta reports whether pattern contains unescaped glob characters.
+func HasMeta(pattern string) bool {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '*', '?', '[':
+			return true
+		}
+	}
+	return false
+}
+
+// Unescape removes backslash escapes from pattern.
+func Unescape(pattern string) string {
+	if !strings.Contains(pattern, `\`) {
+		return pattern
+	}
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '\\' && i+1 < len(pattern) {
+			i++
+		}
+		b.WriteByte(pattern[i])
+	}
+	return b.String()
+}
+
+// QuoteMeta escapes every glob character in s.
+func QuoteMeta(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// Match reports whether name matches pattern in full. Unlike filename
+// expansion, '/' and leading dots are ordinary characters here, which is
+// what the shell's case statement needs.
+func Match(pattern, name string) (bool, error) {
+	return match(pattern, name, false, false)
+}
+
+// MatchName reports whether a single path component matches pattern,
+// applying the leading-dot rule of filename expansion.
+func MatchName(pattern, name string) (bool, error) {
+	if strings.HasPrefix(name, ".") && !strings.HasPrefix(Unescape(pattern), ".") {
+		return false, nil
+	}
+	return match(pattern, name, true, foldCase)
+}
+
+func match(pattern, name string, stopAtSlash, fold bool) (bool, error) {
+	// Classic backtracking on the most recent star.
+	px, nx := 0, 0
+	starPx, starNx := -1, -1
+	for px < len(pattern) || nx < len(name) {
+		if px < len(pattern) {
+			switch c := pattern[px]; c {
+			case '*':
+				starPx, starNx = px, nx
+				px++
+				continue
+			case '?':
+				if nx < len(name) && !(stopAtSlash && name[nx] == '/') {
+					_, w := utf8.DecodeRuneInString(name[nx:])
+					px++
+					nx += w
+					continue
+				}
+			case '[':
+				if nx < len(name) {
+					r, w := utf8.DecodeRuneInString(name[nx:])
+					ok, n, err := matchClass(pattern[px:], r, fold)
+					if err != nil {
+						return false, err
+					}
+					if ok && !(stopAtSlash && r == '/') {
+						px += n
+						nx += w
+						continue
+					}
+				}
+			default:
+				if c == '\\' && px+1 < len(pattern) {
+					px++
+					c = pattern[px]
+				}
+				if fold && nx < len(name) {
+					_, pw := utf8.DecodeRuneInString(pattern[px:])
+					_, w := utf8.DecodeRuneInString(name[nx:])
+					if strings.EqualFold(pattern[px:px+pw], name[nx:nx+w]) {
+						px += pw
+						nx += w
+						continue
+					}
+				} else if nx < len(name) && name[nx] == c {
+					px++
+					nx++
+					continue
+				}
+			}
+		}
+		// Mismatch: let the last star swallow one more character.
+		if starPx >= 0 && starNx < len(name) && !(stopAtSlash && name[starNx] == '/') {
+			_, w := utf8.DecodeRuneInString(name[starNx:])
+			starNx += w
+			px, nx = starPx+1, starNx
+			continue
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// matchClass matches r against the class at the start of pattern and
+// returns the class length in bytes. With fold, r also matches its
+// other cases.
+func matchClass(pattern string, r rune, fold bool) (matched bool, n int, err error) {
+	i := 1
+	negate := false
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
+	}
+	first := true
+	for {
+		if i >= len(pattern) {
+			return false, 0, ErrBadPattern
+		}
+		if pattern[i] == ']' && !first {
+			i++
+			break
+		}
+		first = false
+		lo, w := classChar(pattern[i:])
+		i += w
+		hi := lo
+		if i+1 < len(pattern) && pattern[i] == '-' && pattern[i+1] != ']' {
+			hi, w = classChar(pattern[i+1:])
+			i += 1 + w
+		}
+		if lo <= r && r <= hi {
+			matched = true
+		} else if fold {
+			for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+				if lo <= f && f <= hi {
+					matched = true
+					break
+				}
+			}
+		}
+	}
+	return matched != negate, i, nil
+}
+
+func classChar(s string) (rune, int) {
+	if s[0] == '\\' && len(s) > 1 {
+		r, w := utf8.DecodeRuneInString(s[1:])
+		return r, w + 1
+	}
+	return utf8.DecodeRuneInString(s)
+}
+
+// Glob returns the names in fsys matching pattern, sorted. Relative
+// patterns are resolved against dir but returned relative, as typed. A
+// pattern without glob characters is returned only if the file exists.
+func Glob(fsys vfs.FS, dir, pattern string) ([]string, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	// Split off the literal prefix ("/", "C:/", "src/") that needs no matching.
+	root := ""
+	rest := pattern
+	if vol := filepath.VolumeName(pattern); vol != "" {
+		root, rest = vol, pattern[len(vol):]
+	}
+	if strings.HasPrefix(rest, "/") {
+		root += "/"
+		rest = strings.TrimLeft(rest, "/")
+	}
+
+	var parts []string
+	for _, part := range strings.Split(rest, "/") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	// A trailing '/' keeps only directories, so a final "**" then
+	// behaves as it does in the middle of a pattern.
+	dirsOnly := strings.HasSuffix(pattern, "/")
+	matches := []string{root}
+	for i, part := range parts {
+		var next []string
+		for _, m := range matches {
+			var found []string
+			var err error
+			if part == "**" {
+				found = expandRecursive(fsys, dir, m, i == len(parts)-1 && !dirsOnly)
+			} else {
+				found, err = expandComponent(fsys, dir, m, part)
+			}
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, found...)
+		}
+		matches = dedupe(next)
+		if len(matches) == 0 {
+			return nil, nil
+		}
+	}
+
+	if dirsOnly {
+		var dirs []string
+		for _, m := range matches {
+			if fi, err := fsys.Stat(resolve(dir, m)); err == nil && fi.IsDir() {
+				dirs = append(dirs, m+"/")
+			}
+		}
+		matches = dirs
+	}
+
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// expandRecursive expands a "**" component inside prefix. In the middle
+// of a pattern it yields prefix and every directory below it; as the
+// final component it yields every file and directory below prefix.
+// Hidden entries are skipped and symbolic links are not followed.
+func expandRecursive(fsys vfs.FS, dir, prefix string, final bool) []string {
+	var out []string
+	if !final {
+		out = append(out, prefix)
+	}
+	var walk func(p string)
+	walk = func(p string) {
+		entries, err := fsys.ReadDir(resolve(dir, p))
+		if err != nil {
+			return
+		}
+		for _, e := range entries {
+			if strings.HasPrefix(e.Name(), ".") {
+				continue
+			}
+			name := join(p, e.Name())
+			if e.IsDir() {
+				out = append(out, name)
+				walk(name)
+			} else if final {
+				out = append(out, name)
+			}
+		}
+	}
+	walk(prefix)
+	return out
+}
+
+func dedupe(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	out := names[:0]
+	for _, n := range names {
+		if !seen[n] {
+			seen[n] = true
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// expandComponent matches one pattern component inside prefix.
+func expandComponent(fsys vfs.FS, dir, prefix, part string) ([]string, error) {
+	if !HasMeta(part) {
+		name := join(prefix, Unescape(part))
+		if _, err := fsys.Lstat(resolve(dir, name)); err != nil {
+			return nil, nil
+		}
+		return []string{name}, nil
+	}
+
+	entries, err := fsys.ReadDir(resolve(dir, prefix))
+	if err != nil {
+		return nil, nil
+	}
+	var out []string
+	for _, e := range entries {
+		ok, err := MatchName(part, e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, join(prefix, e.Name()))
+		}
+	}
+	return out, nil
+}
+
+func join(prefix, name string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix + name
+	}
+	return prefix + "/" + name
+}
+
+func resolve(dir, name string) string {
+	if name == "" {
+		name = "."
+	}
+	if dir == "" || filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return filepath.FromSlash(name)
+	}
+	return filepath.Join(dir, filepath.FromSlash(name))
+}
@@ -0,0 +1,220 @@
+package glob
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"", "", true},
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"a*", "a", true},
+		{"a*", "abc", true},
+		{"*c", "abc", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abcd", false},
+		{"*a*b*", "xaybz", true},
+		{"a*b*c", "aXbYbZc", true},
+		{"?", "é", true},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"[abc]", "b", true},
+		{"[abc]", "d", false},
+		{"[a-c]x", "bx", true},
+		{"[!a-c]", "d", true},
+		{"[^a-c]", "b", false},
+		{"[]]", "]", true},
+		{"[a-]", "-", true},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{`a\?`, "a?", true},
+		{"*", ".hidden", true}, // Match has no leading-dot rule
+		{"*", "dir/file", true},
+		{"*.txt", "a.TXT", false},
+	}
+	for _, tt := range tests {
+		got, err := Match(tt.pattern, tt.name)
+		if err != nil {
+			t.Errorf("Match(%q, %q): %v", tt.pattern, tt.name, err)
+		} else if got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := Match("[abc", "a"); err != ErrBadPattern {
+		t.Errorf("Match with an unclosed class: error = %v, want ErrBadPattern", err)
+	}
+}
+
+func TestMatchSlash(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*", "a/b", false},
+		{"a?b", "a/b", false},
+		{"a[/]b", "a/b", false},
+		{"a/*", "a/b", true},
+	}
+	for _, tt := range tests {
+		got, err := match(tt.pattern, tt.name, true, false)
+		if err != nil {
+			t.Errorf("match(%q, %q): %v", tt.pattern, tt.name, err)
+		} else if got != tt.want {
+			t.Errorf("match(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMatchFold(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*.txt", "README.TXT", true},
+		{"readme*", "ReadMe.md", true},
+		{"[a-c]*", "Beta", true},
+		{"[!a-c]*", "Beta", false},
+		{"ÄBC", "äbc", true},
+		{"*.txt", "a.md", false},
+	}
+	for _, tt := range tests {
+		got, err := match(tt.pattern, tt.name, true, true)
+		if err != nil {
+			t.Errorf("match(%q, %q): %v", tt.pattern, tt.name, err)
+		} else if got != tt.want {
+			t.Errorf("match(%q, %q) folded = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMatchName(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"*", ".profile", false},
+		{"?profile", ".profile", false},
+		{".*", ".profile", true},
+		{`\.p*`, ".profile", true},
+		{"*", "profile", true},
+	}
+	for _, tt := range tests {
+		got, err := MatchName(tt.pattern, tt.name)
+		if err != nil {
+			t.Errorf("MatchName(%q, %q): %v", tt.pattern, tt.name, err)
+		} else if got != tt.want {
+			t.Errorf("MatchName(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEscapes(t *testing.T) {
+	if !HasMeta("a*") || !HasMeta("[x]") || HasMeta(`a\*`) || HasMeta("plain") {
+		t.Error("HasMeta misreports")
+	}
+	if got := Unescape(`a\*b\\c`); got != `a*b\c` {
+		t.Errorf("Unescape = %q", got)
+	}
+	if got := QuoteMeta("a*[b]?"); got != `a\*\[b\]\?` {
+		t.Errorf("QuoteMeta = %q", got)
+	}
+	if ok, _ := Match(QuoteMeta("[x]*"), "[x]*"); !ok {
+		t.Error("a quoted name does not match itself")
+	}
+}
+
+func newTree(t *testing.T) vfs.FS {
+	t.Helper()
+	fsys := vfs.NewMem(time.Now)
+	for _, name := range []string{
+		"/work/a.txt",
+		"/work/b.txt",
+		"/work/c.go",
+		"/work/README.TXT",
+		"/work/.hidden.txt",
+		"/work/src/main.go",
+		"/work/src/util/util.go",
+		"/work/src/util/util_test.go",
+		"/work/src/.git/config",
+		"/work/docs/guide.md",
+	} {
+		if err := fsys.AddFile(name, "", 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fsys
+}
+
+func TestGlob(t *testing.T) {
+	fsys := newTree(t)
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"*.txt", []string{"a.txt", "b.txt"}},
+		{"?.*", []string{"a.txt", "b.txt", "c.go"}},
+		{"[ab].txt", []string{"a.txt", "b.txt"}},
+		{".*.txt", []string{".hidden.txt"}},
+		{"*/", []string{"docs/", "src/"}},
+		{"src/*.go", []string{"src/main.go"}},
+		{"*/*.go", []string{"src/main.go"}},
+		{"/work/*.go", []string{"/work/c.go"}},
+		{"src/util", []string{"src/util"}},
+		{"missing", nil},
+		{"*.none", nil},
+		{`a\.txt`, []string{"a.txt"}},
+
+		// "**" matches zero or more directories but not hidden ones.
+		{"**/*.go", []string{"c.go", "src/main.go", "src/util/util.go", "src/util/util_test.go"}},
+		{"src/**/util*.go", []string{"src/util/util.go", "src/util/util_test.go"}},
+		{"src/**", []string{"src/main.go", "src/util", "src/util/util.go", "src/util/util_test.go"}},
+		{"**/config", nil},
+		{"src/**/", []string{"src/", "src/util/"}},
+	}
+	for _, tt := range tests {
+		got, err := Glob(fsys, "/work", tt.pattern)
+		if err != nil {
+			t.Errorf("Glob(%q): %v", tt.pattern, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Glob(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+
+	if _, err := Glob(fsys, "/work", "[a.txt"); err != ErrBadPattern {
+		t.Errorf("Glob with an unclosed class: error = %v, want ErrBadPattern", err)
+	}
+}
+
+func TestGlobFoldCase(t *testing.T) {
+	fsys := newTree(t)
+	defer func(old bool) { foldCase = old }(foldCase)
+
+	foldCase = false
+	got, err := Glob(fsys, "/work", "*.TXT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"README.TXT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("case-sensitive Glob = %q, want %q", got, want)
+	}
+
+	foldCase = true
+	got, err = Glob(fsys, "/work", "*.TXT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"README.TXT", "a.txt", "b.txt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("case-folding Glob = %q, want %q", got, want)
+	}
+}
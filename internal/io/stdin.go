@@ -2,13 +2,28 @@
 package io
 
 import (
+	"io"
 	"os"
 )
 
 // IsPiped returns true if stdin is receiving piped input.
 // This enables: type file.txt | winux grep error
 func IsPiped() bool {
-	stat, err := os.Stdin.Stat()
+	return IsPipedReader(os.Stdin)
+}
+
+// IsPipedReader reports whether r delivers non-interactive input.
+// Readers that are not files (pipes, buffers) always count as piped;
+// files count as piped unless they are a console or terminal.
+func IsPipedReader(r io.Reader) bool {
+	if r == nil {
+		return false
+	}
+	f, ok := r.(*os.File)
+	if !ok {
+		return true
+	}
+	stat, err := f.Stat()
 	if err != nil {
 		return false
 	}
@@ -0,0 +1,214 @@
+// Package lscolors chooses the colour of a file name from the LS_COLORS
+// environment variable, in the format written by GNU dircolors.
+//
+// LS_COLORS is a colon-separated list of KEY=STYLE entries, where STYLE
+// is a list of SGR parameters such as 01;34. A KEY is a two-letter file
+// type code (di for directories, ln for symbolic links, ex for
+// executables, ...) or a *SUFFIX pattern, usually an extension such as
+// *.zip, matched ignoring case. Two keys are winux additions: jn for
+// Windows junctions and hi for hidden files that get no other colour.
+package lscolors
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/term"
+)
+
+// Env is the environment variable holding the colours.
+const Env = "LS_COLORS"
+
+// typeKeys are the file type codes that LS_COLORS may set.
+var typeKeys = map[string]bool{
+	"no": true, "fi": true, "rs": true, "di": true, "ln": true, "mh": true,
+	"pi": true, "so": true, "do": true, "bd": true, "cd": true, "or": true,
+	"mi": true, "su": true, "sg": true, "ca": true, "tw": true, "ow": true,
+	"st": true, "ex": true, "lc": true, "rc": true, "ec": true, "cl": true,
+	"jn": true, "hi": true,
+}
+
+// defaultTypes are the type colours of GNU dircolors, with junctions
+// coloured like links and hidden files dimmed.
+const defaultTypes = "rs=0:di=01;34:ln=01;36:mh=00:pi=40;33:so=01;35:do=01;35:bd=40;33;01:" +
+	"cd=40;33;01:or=40;31;01:mi=00:su=37;41:sg=30;43:ca=00:tw=30;42:ow=34;42:st=37;44:" +
+	"ex=01;32:jn=36:hi=02"
+
+// defaultExts colour common extensions, including the executables and
+// scripts that Windows runs by extension.
+const defaultExts = "*.exe=01;32:*.com=01;32:*.bat=01;32:*.cmd=01;32:*.ps1=01;32:*.msi=01;32:" +
+	"*.tar=01;31:*.tgz=01;31:*.zip=01;31:*.gz=01;31:*.bz2=01;31:*.xz=01;31:*.zst=01;31:" +
+	"*.7z=01;31:*.rar=01;31:*.cab=01;31:*.jpg=01;35:*.jpeg=01;35:*.png=01;35:*.gif=01;35:" +
+	"*.bmp=01;35:*.svg=01;35:*.webp=01;35:*.ico=01;35:*.mp4=01;35:*.mkv=01;35:*.avi=01;35:" +
+	"*.mov=01;35:*.mp3=00;36:*.flac=00;36:*.wav=00;36:*.ogg=00;36"
+
+// Colors maps files to styles.
+type Colors struct {
+	types map[string]term.Style
+	exts  []ext
+
+	// linkTarget is set by ln=target: links take the colour of the
+	// file they point to.
+	linkTarget bool
+}
+
+type ext struct {
+	suffix string // lower case
+	style  term.Style
+}
+
+// Default returns the colours used when LS_COLORS is not set.
+func Default() *Colors {
+	c, err := Parse(defaultTypes + ":" + defaultExts)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// FromEnv returns the colours in LS_COLORS, or the defaults if it is
+// unset or empty.
+func FromEnv(getenv func(string) string) (*Colors, error) {
+	s := getenv(Env)
+	if s == "" {
+		return Default(), nil
+	}
+	return Parse(s)
+}
+
+// Parse parses an LS_COLORS value. File types it does not set keep
+// their default colours; extensions are only those it lists.
+func Parse(s string) (*Colors, error) {
+	def, err := parse(defaultTypes, nil)
+	if err != nil {
+		return nil, err
+	}
+	return parse(s, def.types)
+}
+
+func parse(s string, types map[string]term.Style) (*Colors, error) {
+	c := &Colors{types: make(map[string]term.Style)}
+	for k, v := range types {
+		c.types[k] = v
+	}
+	for _, entry := range strings.Split(s, ":") {
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("missing '=' in %q", entry)
+		case strings.HasPrefix(key, "*"):
+			suffix := strings.ToLower(key[1:])
+			// A later entry for the same suffix wins.
+			for i := range c.exts {
+				if c.exts[i].suffix == suffix {
+					c.exts = append(c.exts[:i], c.exts[i+1:]...)
+					break
+				}
+			}
+			c.exts = append(c.exts, ext{suffix, term.Style(value)})
+		case key == "ln" && value == "target":
+			c.linkTarget = true
+		case typeKeys[key]:
+			c.types[key] = term.Style(value)
+		default:
+			// GNU ls ignores unknown keys too, so newer dircolors
+			// output keeps working.
+		}
+	}
+	return c, nil
+}
+
+// File describes a file to colour.
+type File struct {
+	Name     string
+	Mode     fs.FileMode
+	Exec     bool // can be run; by extension on Windows
+	Hidden   bool
+	Junction bool // a Windows junction (mount point)
+	Orphan   bool // a symbolic link to a missing file
+	Links    int  // hard links; 0 if unknown
+
+	// Target is the file a symbolic link points to, if known; it is
+	// coloured instead of the link with ln=target.
+	Target *File
+}
+
+// Style returns the style for f, or "" for none. As in GNU ls, suffix
+// patterns only apply to regular files that are not executable.
+func (c *Colors) Style(f File) term.Style {
+	mode := f.Mode
+	key := "fi"
+	switch {
+	case f.Junction:
+		key = "jn"
+	case mode&fs.ModeSymlink != 0:
+		if f.Orphan {
+			key = "or"
+		} else if c.linkTarget && f.Target != nil {
+			return c.Style(*f.Target)
+		} else {
+			key = "ln"
+		}
+	case mode.IsDir():
+		switch {
+		case mode&fs.ModeSticky != 0 && mode&0002 != 0:
+			key = "tw"
+		case mode&0002 != 0:
+			key = "ow"
+		case mode&fs.ModeSticky != 0:
+			key = "st"
+		default:
+			key = "di"
+		}
+	case mode&fs.ModeNamedPipe != 0:
+		key = "pi"
+	case mode&fs.ModeSocket != 0:
+		key = "so"
+	case mode&fs.ModeCharDevice != 0:
+		key = "cd"
+	case mode&fs.ModeDevice != 0:
+		key = "bd"
+	case !mode.IsRegular():
+		key = "no"
+	case mode&fs.ModeSetuid != 0:
+		key = "su"
+	case mode&fs.ModeSetgid != 0:
+		key = "sg"
+	case f.Exec:
+		key = "ex"
+	case f.Links > 1 && visible(c.types["mh"]) != "":
+		key = "mh"
+	}
+
+	if key == "fi" {
+		name := strings.ToLower(f.Name)
+		for i := len(c.exts) - 1; i >= 0; i-- {
+			if strings.HasSuffix(name, c.exts[i].suffix) {
+				return visible(c.exts[i].style)
+			}
+		}
+		if f.Hidden && c.types["hi"] != "" {
+			key = "hi"
+		}
+	}
+	if s := visible(c.types[key]); s != "" {
+		return s
+	}
+	if key != "fi" && key != "no" {
+		// Types without a colour of their own fall back to plain files.
+		return visible(c.types["fi"])
+	}
+	return ""
+}
+
+// visible returns s, or "" if it leaves text unstyled.
+func visible(s term.Style) term.Style {
+	if s == "0" || s == "00" {
+		return ""
+	}
+	return s
+}
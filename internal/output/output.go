@@ -0,0 +1,121 @@
+// Package output writes command results for scripts as JSON, a stream
+// of JSON objects, or the command's usual text.
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is how a command writes its results.
+type Format int
+
+const (
+	Text   Format = iota // the command's usual output
+	JSON                 // one JSON document: an object or an array
+	NDJSON               // one JSON object per line
+)
+
+var names = []string{"text", "json", "ndjson"}
+
+// Names lists the formats Parse accepts.
+func Names() []string {
+	return append([]string(nil), names...)
+}
+
+func (f Format) String() string {
+	if int(f) < len(names) {
+		return names[f]
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// Parse returns the format called name.
+func Parse(name string) (Format, error) {
+	for i, n := range names {
+		if strings.EqualFold(name, n) {
+			return Format(i), nil
+		}
+	}
+	return Text, fmt.Errorf("invalid output format '%s' (want %s)", name, strings.Join(names, ", "))
+}
+
+// Structured reports whether f is a JSON format.
+func (f Format) Structured() bool {
+	return f == JSON || f == NDJSON
+}
+
+// encode returns v as JSON without HTML escaping, indented for JSON
+// and on one line for NDJSON.
+func encode(f Format, v any, prefix string) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if f == JSON {
+		enc.SetIndent(prefix, "  ")
+	}
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// Write writes the single result v, such as the user of whoami, as a
+// JSON object on its own line.
+func Write(w io.Writer, f Format, v any) error {
+	data, err := encode(f, v, "")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// List writes a sequence of results as they are produced: a JSON array
+// for JSON, or one object per line for NDJSON. Close ends the array.
+type List struct {
+	w io.Writer
+	f Format
+	n int
+}
+
+// NewList returns a List writing to w in format f, which must be
+// structured.
+func NewList(w io.Writer, f Format) *List {
+	return &List{w: w, f: f}
+}
+
+// Add writes the next result.
+func (l *List) Add(v any) error {
+	data, err := encode(l.f, v, "  ")
+	if err != nil {
+		return err
+	}
+	switch {
+	case l.f == NDJSON:
+		_, err = fmt.Fprintf(l.w, "%s\n", data)
+	case l.n == 0:
+		_, err = fmt.Fprintf(l.w, "[\n  %s", data)
+	default:
+		_, err = fmt.Fprintf(l.w, ",\n  %s", data)
+	}
+	l.n++
+	return err
+}
+
+// Close ends the JSON array, writing an empty one if nothing was
+// added.
+func (l *List) Close() error {
+	if l.f != JSON {
+		return nil
+	}
+	end := "\n]\n"
+	if l.n == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(l.w, end)
+	return err
+}
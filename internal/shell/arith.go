@@ -0,0 +1,326 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxArithDepth bounds variables that refer to themselves, as in x=x+1.
+const maxArithDepth = 1024
+
+// arith evaluates a $((...)) expression with C integer semantics.
+func (sh *Shell) arith(expr string) (int64, error) {
+	a := &arithParser{sh: sh, src: expr}
+	a.next()
+	v, err := a.assign()
+	if err != nil {
+		return 0, err
+	}
+	if a.tok != "" {
+		return 0, fmt.Errorf("%s: syntax error in expression (error token is \"%s\")", expr, a.tok)
+	}
+	return v, nil
+}
+
+type arithParser struct {
+	sh  *Shell
+	src string
+	pos int
+	tok string // current token; "" at end
+}
+
+// arithOps lists operators longest first.
+var arithOps = []string{
+	"<<=", ">>=", "**", "++", "--", "<<", ">>", "<=", ">=", "==", "!=", "&&", "||",
+	"+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=",
+	"+", "-", "*", "/", "%", "<", ">", "&", "|", "^", "!", "~", "(", ")", "?", ":", "=",
+}
+
+func (a *arithParser) next() {
+	for a.pos < len(a.src) && strings.IndexByte(" \t\n\r", a.src[a.pos]) >= 0 {
+		a.pos++
+	}
+	if a.pos >= len(a.src) {
+		a.tok = ""
+		return
+	}
+	c := a.src[a.pos]
+	if isArithWord(c) {
+		start := a.pos
+		for a.pos < len(a.src) && isArithWord(a.src[a.pos]) {
+			a.pos++
+		}
+		a.tok = a.src[start:a.pos]
+		return
+	}
+	for _, op := range arithOps {
+		if strings.HasPrefix(a.src[a.pos:], op) {
+			a.tok = op
+			a.pos += len(op)
+			return
+		}
+	}
+	a.tok = string(c)
+	a.pos++
+}
+
+func isArithWord(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// assign handles = and the compound assignment operators.
+func (a *arithParser) assign() (int64, error) {
+	if isName(a.tok) {
+		save, saveTok := a.pos, a.tok
+		name := a.tok
+		a.next()
+		if op := a.tok; op == "=" || (len(op) >= 2 && strings.HasSuffix(op, "=") && op != "==" && op != "<=" && op != ">=" && op != "!=") {
+			a.next()
+			rhs, err := a.assign()
+			if err != nil {
+				return 0, err
+			}
+			if op != "=" {
+				cur, err := a.variable(name)
+				if err != nil {
+					return 0, err
+				}
+				rhs, err = binary(strings.TrimSuffix(op, "="), cur, rhs)
+				if err != nil {
+					return 0, err
+				}
+			}
+			a.sh.setVar(name, strconv.FormatInt(rhs, 10))
+			return rhs, nil
+		}
+		a.pos, a.tok = save, saveTok
+	}
+	return a.ternary()
+}
+
+func (a *arithParser) ternary() (int64, error) {
+	cond, err := a.binary(0)
+	if err != nil {
+		return 0, err
+	}
+	if a.tok != "?" {
+		return cond, nil
+	}
+	a.next()
+	x, err := a.assign()
+	if err != nil {
+		return 0, err
+	}
+	if a.tok != ":" {
+		return 0, errors.New("expected ':' in conditional expression")
+	}
+	a.next()
+	y, err := a.assign()
+	if err != nil {
+		return 0, err
+	}
+	if cond != 0 {
+		return x, nil
+	}
+	return y, nil
+}
+
+// precedence of binary operators, lowest first.
+var arithPrec = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, "<=": 7, ">": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10, "**": 11,
+}
+
+func (a *arithParser) binary(minPrec int) (int64, error) {
+	x, err := a.unary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := a.tok
+		prec, ok := arithPrec[op]
+		if !ok || prec <= minPrec {
+			return x, nil
+		}
+		a.next()
+		next := prec
+		if op == "**" {
+			next-- // right associative
+		}
+		y, err := a.binary(next)
+		if err != nil {
+			return 0, err
+		}
+		x, err = binary(op, x, y)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func binary(op string, x, y int64) (int64, error) {
+	b := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	switch op {
+	case "||":
+		return b(x != 0 || y != 0), nil
+	case "&&":
+		return b(x != 0 && y != 0), nil
+	case "|":
+		return x | y, nil
+	case "^":
+		return x ^ y, nil
+	case "&":
+		return x & y, nil
+	case "==":
+		return b(x == y), nil
+	case "!=":
+		return b(x != y), nil
+	case "<":
+		return b(x < y), nil
+	case "<=":
+		return b(x <= y), nil
+	case ">":
+		return b(x > y), nil
+	case ">=":
+		return b(x >= y), nil
+	case "<<":
+		return x << uint64(y), nil
+	case ">>":
+		return x >> uint64(y), nil
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/", "%":
+		if y == 0 {
+			return 0, errors.New("division by 0")
+		}
+		if op == "/" {
+			return x / y, nil
+		}
+		return x % y, nil
+	case "**":
+		if y < 0 {
+			return 0, errors.New("exponent less than 0")
+		}
+		r := int64(1)
+		for ; y > 0; y-- {
+			r *= x
+		}
+		return r, nil
+	}
+	return 0, fmt.Errorf("unknown operator %s", op)
+}
+
+func (a *arithParser) unary() (int64, error) {
+	switch op := a.tok; op {
+	case "+", "-", "!", "~":
+		a.next()
+		x, err := a.unary()
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "-":
+			return -x, nil
+		case "!":
+			if x == 0 {
+				return 1, nil
+			}
+			return 0, nil
+		case "~":
+			return ^x, nil
+		}
+		return x, nil
+	case "++", "--":
+		a.next()
+		name := a.tok
+		if !isName(name) {
+			return 0, fmt.Errorf("%s: operand expected", op)
+		}
+		a.next()
+		v, err := a.variable(name)
+		if err != nil {
+			return 0, err
+		}
+		if op == "++" {
+			v++
+		} else {
+			v--
+		}
+		a.sh.setVar(name, strconv.FormatInt(v, 10))
+		return v, nil
+	}
+	return a.primary()
+}
+
+func (a *arithParser) primary() (int64, error) {
+	tok := a.tok
+	switch {
+	case tok == "":
+		return 0, errors.New("syntax error: operand expected")
+	case tok == "(":
+		a.next()
+		v, err := a.assign()
+		if err != nil {
+			return 0, err
+		}
+		if a.tok != ")" {
+			return 0, errors.New("missing ')'")
+		}
+		a.next()
+		return v, nil
+	case tok[0] >= '0' && tok[0] <= '9':
+		a.next()
+		v, err := strconv.ParseInt(tok, 0, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s: value too great for base", tok)
+		}
+		return v, nil
+	case isName(tok):
+		a.next()
+		v, err := a.variable(tok)
+		if err != nil {
+			return 0, err
+		}
+		if a.tok == "++" || a.tok == "--" {
+			nv := v + 1
+			if a.tok == "--" {
+				nv = v - 1
+			}
+			a.sh.setVar(tok, strconv.FormatInt(nv, 10))
+			a.next()
+		}
+		return v, nil
+	}
+	return 0, fmt.Errorf("syntax error: operand expected (error token is \"%s\")", tok)
+}
+
+// variable reads a variable as an integer; unset and empty are 0.
+func (a *arithParser) variable(name string) (int64, error) {
+	s, _ := a.sh.lookup(name)
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if v, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return v, nil
+	}
+	// Values may themselves be expressions, as in bash.
+	if a.sh.arithDepth >= maxArithDepth {
+		return 0, fmt.Errorf("%s: expression recursion level exceeded", name)
+	}
+	a.sh.arithDepth++
+	defer func() { a.sh.arithDepth-- }()
+	return a.sh.arith(s)
+}
@@ -0,0 +1,104 @@
+package shell
+
+// Command is any executable node of the syntax tree.
+type Command interface{}
+
+// List is a sequence of and-or lists separated by ';', '&' or newlines.
+type List struct {
+	Items []*AndOr
+}
+
+// AndOr is a chain of pipelines joined by && and ||.
+type AndOr struct {
+	Pipes      []*Pipeline
+	Ops        []string // Ops[i] joins Pipes[i] and Pipes[i+1]
+	Background bool
+	Text       string // source text, as shown by jobs
+}
+
+// Pipeline is a sequence of commands joined by '|'.
+type Pipeline struct {
+	Negate bool
+	Cmds   []Command
+}
+
+// Assign is a NAME=value prefix of a simple command.
+type Assign struct {
+	Name  string
+	Value string // raw word
+}
+
+// Redir is a redirection such as 2>>log or <<EOF.
+type Redir struct {
+	Fd   int    // target descriptor
+	Op   string // <, >, >>, >|, <>, <&, >&, &>, << or <<-
+	Word string // raw word; the delimiter for here-documents
+	Body string // here-document body
+	// Quoted is set when a here-document delimiter was quoted,
+	// which disables expansion of the body.
+	Quoted bool
+}
+
+// SimpleCmd is a command name with arguments, assignments and redirections.
+type SimpleCmd struct {
+	Assigns []Assign
+	Words   []string // raw words
+	Redirs  []*Redir
+	Line    int
+}
+
+// BraceGroup is { list; }.
+type BraceGroup struct {
+	Body *List
+}
+
+// Subshell is ( list ).
+type Subshell struct {
+	Body *List
+}
+
+// IfCmd is if/elif/else/fi.
+type IfCmd struct {
+	Conds  []*List
+	Bodies []*List
+	Else   *List
+}
+
+// ForCmd is for NAME [in WORDS]; do list; done.
+type ForCmd struct {
+	Var   string
+	Items []string // raw words
+	HasIn bool
+	Body  *List
+}
+
+// WhileCmd is while/until list; do list; done.
+type WhileCmd struct {
+	Cond  *List
+	Body  *List
+	Until bool
+}
+
+// CaseCmd is case WORD in PATTERN) list;; ... esac.
+type CaseCmd struct {
+	Word  string
+	Items []CaseItem
+}
+
+// CaseItem is one arm of a case statement.
+type CaseItem struct {
+	Patterns []string
+	Body     *List
+}
+
+// FuncDef defines a shell function.
+type FuncDef struct {
+	Name string
+	Body Command
+}
+
+// Redirected wraps a compound command that carries redirections.
+type Redirected struct {
+	Cmd    Command
+	Redirs []*Redir
+}
@@ -0,0 +1,559 @@
+package shell
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// builtinFunc implements a shell builtin. argv[0] is the builtin name.
+type builtinFunc func(sh *Shell, st stdio, argv []string) int
+
+var builtins map[string]builtinFunc
+
+func init() {
+	builtins = map[string]builtinFunc{
+		":":        builtinTrue,
+		"true":     builtinTrue,
+		"false":    builtinFalse,
+		"cd":       builtinCd,
+		"pwd":      builtinPwd,
+		"export":   builtinExport,
+		"unset":    builtinUnset,
+		"set":      builtinSet,
+		"shift":    builtinShift,
+		"exit":     builtinExit,
+		"return":   builtinReturn,
+		"break":    builtinBreak,
+		"continue": builtinContinue,
+		"source":   builtinSource,
+		".":        builtinSource,
+		"eval":     builtinEval,
+		"alias":    builtinAlias,
+		"unalias":  builtinUnalias,
+		"history":  builtinHistory,
+		"env":      builtinEnv,
+		"local":    builtinLocal,
+		"read":     builtinRead,
+		"test":     builtinTest,
+		"[":        builtinTest,
+		"printf":   builtinPrintf,
+		"type":     builtinType,
+		"command":  builtinCommand,
+		"jobs":     builtinJobs,
+		"wait":     builtinWait,
+	}
+}
+
+func builtinTrue(sh *Shell, st stdio, argv []string) int  { return utils.ExitSuccess }
+func builtinFalse(sh *Shell, st stdio, argv []string) int { return utils.ExitFailure }
+
+func builtinCd(sh *Shell, st stdio, argv []string) int {
+	var target string
+	switch {
+	case len(argv) < 2:
+		target = sh.getenv("HOME")
+		if target == "" {
+			fmt.Fprintln(st.err, "sh: cd: HOME not set")
+			return utils.ExitFailure
+		}
+	case argv[1] == "-":
+		target = sh.getenv("OLDPWD")
+		if target == "" {
+			fmt.Fprintln(st.err, "sh: cd: OLDPWD not set")
+			return utils.ExitFailure
+		}
+		fmt.Fprintln(st.out, target)
+	default:
+		target = argv[1]
+	}
+
+	dir := filepath.Clean(sh.path(target))
+	fi, err := sh.ctx.Files().Stat(dir)
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: cd: %s: %s\n", target, errText(err))
+		return utils.ExitFailure
+	}
+	if !fi.IsDir() {
+		fmt.Fprintf(st.err, "sh: cd: %s: Not a directory\n", target)
+		return utils.ExitFailure
+	}
+	sh.setVar("OLDPWD", sh.dir)
+	sh.setVar("PWD", dir)
+	return utils.ExitSuccess
+}
+
+func builtinPwd(sh *Shell, st stdio, argv []string) int {
+	fmt.Fprintln(st.out, sh.dir)
+	return utils.ExitSuccess
+}
+
+func builtinExport(sh *Shell, st stdio, argv []string) int {
+	args := argv[1:]
+	if len(args) > 0 && args[0] == "-p" {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		for _, k := range sh.sortedVars() {
+			if v := sh.vars[k]; v.exported {
+				if _, err := fmt.Fprintf(st.out, "export %s=%s\n", k, quote(v.value)); err != nil {
+					return utils.ExitFailure
+				}
+			}
+		}
+		return utils.ExitSuccess
+	}
+	status := utils.ExitSuccess
+	for _, a := range args {
+		name, value, hasValue := strings.Cut(a, "=")
+		if !isName(name) {
+			fmt.Fprintf(st.err, "sh: export: '%s': not a valid identifier\n", a)
+			status = utils.ExitFailure
+			continue
+		}
+		if hasValue {
+			sh.setVar(name, value)
+		} else if _, ok := sh.vars[name]; !ok {
+			sh.vars[name] = &variable{}
+		}
+		sh.vars[name].exported = true
+	}
+	return status
+}
+
+func builtinUnset(sh *Shell, st stdio, argv []string) int {
+	funcs := false
+	for _, a := range argv[1:] {
+		switch a {
+		case "-f":
+			funcs = true
+		case "-v":
+			funcs = false
+		default:
+			if funcs {
+				delete(sh.funcs, a)
+			} else {
+				delete(sh.vars, a)
+			}
+		}
+	}
+	return utils.ExitSuccess
+}
+
+func builtinSet(sh *Shell, st stdio, argv []string) int {
+	args := argv[1:]
+	if len(args) == 0 {
+		for _, k := range sh.sortedVars() {
+			if _, err := fmt.Fprintf(st.out, "%s=%s\n", k, quote(sh.vars[k].value)); err != nil {
+				return utils.ExitFailure
+			}
+		}
+		return utils.ExitSuccess
+	}
+	for len(args) > 0 {
+		a := args[0]
+		if a == "--" {
+			sh.args = append([]string(nil), args[1:]...)
+			return utils.ExitSuccess
+		}
+		if len(a) < 2 || (a[0] != '-' && a[0] != '+') {
+			break
+		}
+		on := a[0] == '-'
+		args = args[1:]
+		if a[1:] == "o" {
+			if len(args) == 0 {
+				fmt.Fprintf(st.out, "errexit\t%s\n", onOff(sh.opts.errexit))
+				fmt.Fprintf(st.out, "noglob\t%s\n", onOff(sh.opts.noglob))
+				fmt.Fprintf(st.out, "nounset\t%s\n", onOff(sh.opts.nounset))
+				fmt.Fprintf(st.out, "pipefail\t%s\n", onOff(sh.opts.pipefail))
+				fmt.Fprintf(st.out, "xtrace\t%s\n", onOff(sh.opts.xtrace))
+				return utils.ExitSuccess
+			}
+			if len(args[0]) < 2 || !sh.SetOption(args[0], on) {
+				fmt.Fprintf(st.err, "sh: set: %s: invalid option name\n", args[0])
+				return utils.ExitUsageError
+			}
+			args = args[1:]
+			continue
+		}
+		for i := 1; i < len(a); i++ {
+			if !sh.SetOption(a[i:i+1], on) {
+				fmt.Fprintf(st.err, "sh: set: %c%c: invalid option\n", a[0], a[i])
+				return utils.ExitUsageError
+			}
+		}
+	}
+	if len(args) > 0 {
+		sh.args = append([]string(nil), args...)
+	}
+	return utils.ExitSuccess
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+// countArg parses the optional numeric argument of shift, exit, return,
+// break and continue.
+func countArg(st stdio, argv []string, def int) (int, bool) {
+	if len(argv) < 2 {
+		return def, true
+	}
+	n, err := strconv.Atoi(argv[1])
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: %s: %s: numeric argument required\n", argv[0], argv[1])
+		return 0, false
+	}
+	return n, true
+}
+
+func builtinShift(sh *Shell, st stdio, argv []string) int {
+	n, ok := countArg(st, argv, 1)
+	if !ok || n < 0 || n > len(sh.args) {
+		return utils.ExitFailure
+	}
+	sh.args = sh.args[n:]
+	return utils.ExitSuccess
+}
+
+func builtinExit(sh *Shell, st stdio, argv []string) int {
+	n, ok := countArg(st, argv, sh.status)
+	if !ok {
+		n = utils.ExitUsageError
+	}
+	sh.status = n & 0xff
+	sh.exiting = true
+	return sh.status
+}
+
+func builtinReturn(sh *Shell, st stdio, argv []string) int {
+	n, ok := countArg(st, argv, sh.status)
+	if !ok {
+		n = utils.ExitUsageError
+	}
+	sh.status = n & 0xff
+	sh.returning = true
+	return sh.status
+}
+
+func builtinBreak(sh *Shell, st stdio, argv []string) int {
+	n, ok := countArg(st, argv, 1)
+	if !ok || n < 1 {
+		return utils.ExitFailure
+	}
+	sh.breakN = n
+	return utils.ExitSuccess
+}
+
+func builtinContinue(sh *Shell, st stdio, argv []string) int {
+	n, ok := countArg(st, argv, 1)
+	if !ok || n < 1 {
+		return utils.ExitFailure
+	}
+	sh.continueN = n
+	return utils.ExitSuccess
+}
+
+func builtinSource(sh *Shell, st stdio, argv []string) int {
+	if len(argv) < 2 {
+		fmt.Fprintf(st.err, "sh: %s: filename argument required\n", argv[0])
+		return utils.ExitUsageError
+	}
+	data, err := vfs.ReadFile(sh.ctx.Files(), sh.path(argv[1]))
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: %s: %s\n", argv[1], errText(err))
+		return utils.ExitFailure
+	}
+	if len(argv) > 2 {
+		saved := sh.args
+		sh.args = argv[2:]
+		defer func() { sh.args = saved }()
+	}
+	status := sh.runSource(string(data), st)
+	sh.returning = false
+	return status
+}
+
+func builtinEval(sh *Shell, st stdio, argv []string) int {
+	return sh.runSource(strings.Join(argv[1:], " "), st)
+}
+
+func builtinAlias(sh *Shell, st stdio, argv []string) int {
+	if len(argv) < 2 {
+		names := make([]string, 0, len(sh.aliases))
+		for k := range sh.aliases {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			if _, err := fmt.Fprintf(st.out, "alias %s=%s\n", k, quote(sh.aliases[k])); err != nil {
+				return utils.ExitFailure
+			}
+		}
+		return utils.ExitSuccess
+	}
+	status := utils.ExitSuccess
+	for _, a := range argv[1:] {
+		name, value, ok := strings.Cut(a, "=")
+		if ok {
+			sh.aliases[name] = value
+			continue
+		}
+		if v, found := sh.aliases[name]; found {
+			fmt.Fprintf(st.out, "alias %s=%s\n", name, quote(v))
+		} else {
+			fmt.Fprintf(st.err, "sh: alias: %s: not found\n", name)
+			status = utils.ExitFailure
+		}
+	}
+	return status
+}
+
+func builtinUnalias(sh *Shell, st stdio, argv []string) int {
+	for _, a := range argv[1:] {
+		if a == "-a" {
+			sh.aliases = make(map[string]string)
+			continue
+		}
+		delete(sh.aliases, a)
+	}
+	return utils.ExitSuccess
+}
+
+func builtinHistory(sh *Shell, st stdio, argv []string) int {
+	if len(argv) > 1 && argv[1] == "-c" {
+		sh.history = nil
+		return utils.ExitSuccess
+	}
+	start := 0
+	if len(argv) > 1 {
+		n, err := strconv.Atoi(argv[1])
+		if err != nil {
+			fmt.Fprintf(st.err, "sh: history: %s: numeric argument required\n", argv[1])
+			return utils.ExitUsageError
+		}
+		if n < len(sh.history) {
+			start = len(sh.history) - n
+		}
+	}
+	for i := start; i < len(sh.history); i++ {
+		if _, err := fmt.Fprintf(st.out, "%5d  %s\n", i+1, sh.history[i]); err != nil {
+			return utils.ExitFailure
+		}
+	}
+	return utils.ExitSuccess
+}
+
+// builtinEnv prints the exported environment, or runs a command with
+// extra NAME=value assignments.
+func builtinEnv(sh *Shell, st stdio, argv []string) int {
+	args := argv[1:]
+	var assigns []string
+	for len(args) > 0 && strings.Contains(args[0], "=") {
+		assigns = append(assigns, args[0])
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		for _, kv := range sh.environ(assigns) {
+			if _, err := fmt.Fprintln(st.out, kv); err != nil {
+				return utils.ExitFailure
+			}
+		}
+		return utils.ExitSuccess
+	}
+	return sh.runExternal(args, assigns, st)
+}
+
+func builtinLocal(sh *Shell, st stdio, argv []string) int {
+	if len(sh.locals) == 0 {
+		fmt.Fprintln(st.err, "sh: local: can only be used in a function")
+		return utils.ExitFailure
+	}
+	frame := sh.locals[len(sh.locals)-1]
+	for _, a := range argv[1:] {
+		name, value, _ := strings.Cut(a, "=")
+		if !isName(name) {
+			fmt.Fprintf(st.err, "sh: local: '%s': not a valid identifier\n", a)
+			return utils.ExitFailure
+		}
+		if _, saved := frame[name]; !saved {
+			frame[name] = sh.vars[name]
+		}
+		sh.vars[name] = &variable{value: value}
+	}
+	return utils.ExitSuccess
+}
+
+func builtinRead(sh *Shell, st stdio, argv []string) int {
+	raw := false
+	args := argv[1:]
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "-r":
+			raw = true
+		case "-p":
+			if len(args) > 1 {
+				fmt.Fprint(st.err, args[1])
+				args = args[1:]
+			}
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		args = []string{"REPLY"}
+	}
+
+	line, err := readLine(st.in)
+	if err != nil && line == "" {
+		return utils.ExitFailure
+	}
+	if !raw {
+		line = strings.ReplaceAll(line, "\\\n", "")
+		var b strings.Builder
+		for i := 0; i < len(line); i++ {
+			if line[i] == '\\' && i+1 < len(line) {
+				i++
+			}
+			b.WriteByte(line[i])
+		}
+		line = b.String()
+	}
+
+	ifs, ok := sh.lookup("IFS")
+	if !ok {
+		ifs = " \t\n"
+	}
+	isSep := func(r rune) bool { return strings.ContainsRune(ifs, r) }
+	for i, name := range args {
+		line = strings.TrimLeftFunc(line, isSep)
+		if i == len(args)-1 {
+			sh.setVar(name, strings.TrimRightFunc(line, isSep))
+			break
+		}
+		end := strings.IndexFunc(line, isSep)
+		if end < 0 {
+			end = len(line)
+		}
+		sh.setVar(name, line[:end])
+		line = line[end:]
+	}
+	return utils.ExitSuccess
+}
+
+// readLine reads one line a byte at a time, so no input meant for the
+// next command is consumed.
+func readLine(r io.Reader) (string, error) {
+	if br, ok := r.(*bufio.Reader); ok {
+		line, err := br.ReadString('\n')
+		return strings.TrimRight(line, "\r\n"), err
+	}
+	var b strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := r.Read(buf)
+		if n == 1 {
+			if buf[0] == '\n' {
+				return strings.TrimRight(b.String(), "\r"), nil
+			}
+			b.WriteByte(buf[0])
+		}
+		if err != nil {
+			return b.String(), err
+		}
+	}
+}
+
+func builtinType(sh *Shell, st stdio, argv []string) int {
+	status := utils.ExitSuccess
+	for _, name := range argv[1:] {
+		desc, ok := sh.describe(name)
+		if !ok {
+			fmt.Fprintf(st.err, "sh: type: %s: not found\n", name)
+			status = utils.ExitFailure
+			continue
+		}
+		fmt.Fprintf(st.out, "%s is %s\n", name, desc)
+	}
+	return status
+}
+
+// describe reports how name would be resolved as a command.
+func (sh *Shell) describe(name string) (string, bool) {
+	if v, ok := sh.aliases[name]; ok {
+		return "aliased to '" + v + "'", true
+	}
+	if _, ok := sh.funcs[name]; ok {
+		return "a shell function", true
+	}
+	if _, ok := builtins[name]; ok {
+		return "a shell builtin", true
+	}
+	if _, _, err := core.Lookup(sh.ctx, []string{name}); err == nil {
+		return "a winux command", true
+	}
+	if p, err := sh.lookPath(name); err == nil {
+		return p, true
+	}
+	return "", false
+}
+
+// builtinCommand implements command -v/-V NAME and command NAME ARGS,
+// which bypasses functions and aliases.
+func builtinCommand(sh *Shell, st stdio, argv []string) int {
+	args := argv[1:]
+	if len(args) == 0 {
+		return utils.ExitSuccess
+	}
+	if args[0] == "-v" || args[0] == "-V" {
+		status := utils.ExitSuccess
+		for _, name := range args[1:] {
+			desc, ok := sh.describe(name)
+			if !ok {
+				status = utils.ExitFailure
+				continue
+			}
+			switch {
+			case args[0] == "-V":
+				fmt.Fprintf(st.out, "%s is %s\n", name, desc)
+			case strings.HasPrefix(desc, "a ") || strings.HasPrefix(desc, "aliased"):
+				fmt.Fprintln(st.out, name)
+			default:
+				fmt.Fprintln(st.out, desc)
+			}
+		}
+		return status
+	}
+	if b, ok := builtins[args[0]]; ok {
+		return b(sh, st, args)
+	}
+	return sh.runExternal(args, nil, st)
+}
+
+func (sh *Shell) sortedVars() []string {
+	names := make([]string, 0, len(sh.vars))
+	for k := range sh.vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// quote single-quotes s for reuse as shell input when needed.
+func quote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\n'\"\\$`|&;<>()*?[]#~") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,668 @@
+package shell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/glob"
+	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// runList runs each and-or list in turn.
+func (sh *Shell) runList(l *List, st stdio) int {
+	for _, ao := range l.Items {
+		if sh.stopped() {
+			break
+		}
+		if ao.Background {
+			sh.runBackground(ao, st)
+			continue
+		}
+		sh.status = sh.runAndOr(ao, st)
+	}
+	return sh.status
+}
+
+// stopped reports whether control flow or cancellation ends the
+// current list.
+func (sh *Shell) stopped() bool {
+	return sh.exiting || sh.returning || sh.breakN > 0 || sh.continueN > 0 || sh.ctx.Canceled()
+}
+
+func (sh *Shell) runAndOr(ao *AndOr, st stdio) int {
+	status := sh.runPipeline(ao.Pipes[0], st, len(ao.Pipes) > 1)
+	for i, op := range ao.Ops {
+		if sh.stopped() {
+			return status
+		}
+		if (op == "&&") != (status == utils.ExitSuccess) {
+			continue
+		}
+		sh.status = status
+		status = sh.runPipeline(ao.Pipes[i+1], st, i+1 < len(ao.Pipes)-1)
+	}
+	return status
+}
+
+// runPipeline runs a pipeline. Commands tested by && or || and negated
+// pipelines do not trigger errexit.
+func (sh *Shell) runPipeline(pl *Pipeline, st stdio, tested bool) int {
+	var status int
+	if len(pl.Cmds) == 1 {
+		status = sh.runCommand(pl.Cmds[0], st)
+	} else {
+		status = sh.runStages(pl, st)
+	}
+	if pl.Negate {
+		if status == utils.ExitSuccess {
+			status = utils.ExitFailure
+		} else {
+			status = utils.ExitSuccess
+		}
+	}
+	sh.status = status
+	if sh.opts.errexit && status != utils.ExitSuccess && !pl.Negate && !tested && !sh.inCondition() {
+		sh.exiting = true
+	}
+	return status
+}
+
+// runStages runs a multi-command pipeline, each stage in a subshell
+// canceled once the stage after it exits.
+func (sh *Shell) runStages(pl *Pipeline, st stdio) int {
+	codes := core.RunStages(sh.ctx.Context, len(pl.Cmds), st.in, st.out, st.err,
+		func(i int, ctx context.Context, stdin io.Reader, stdout, stderr io.Writer) int {
+			sub := sh.subshell()
+			sub.ctx = sh.ctx.WithContext(ctx)
+			return sub.runCommand(pl.Cmds[i], stdio{in: stdin, out: stdout, err: stderr})
+		})
+	return core.PipelineStatus(codes, sh.opts.pipefail)
+}
+
+// inCondition reports whether an if or while condition is being
+// evaluated, where errexit is suspended.
+func (sh *Shell) inCondition() bool {
+	return sh.condDepth > 0
+}
+
+func (sh *Shell) runCondition(l *List, st stdio) int {
+	sh.condDepth++
+	defer func() { sh.condDepth-- }()
+	return sh.runList(l, st)
+}
+
+func (sh *Shell) runCommand(cmd Command, st stdio) int {
+	switch c := cmd.(type) {
+	case *SimpleCmd:
+		return sh.runSimple(c, st)
+	case *Redirected:
+		rio, closeAll, err := sh.redirect(c.Redirs, st)
+		if err != nil {
+			fmt.Fprintf(st.err, "sh: %v\n", err)
+			return utils.ExitFailure
+		}
+		defer closeAll()
+		return sh.runCommand(c.Cmd, rio)
+	case *BraceGroup:
+		return sh.runList(c.Body, st)
+	case *Subshell:
+		sub := sh.subshell()
+		status := sub.runList(c.Body, st)
+		return status
+	case *IfCmd:
+		for i, cond := range c.Conds {
+			if sh.runCondition(cond, st) == utils.ExitSuccess {
+				if sh.stopped() {
+					return sh.status
+				}
+				return sh.runList(c.Bodies[i], st)
+			}
+			if sh.stopped() {
+				return sh.status
+			}
+		}
+		if c.Else != nil {
+			return sh.runList(c.Else, st)
+		}
+		return utils.ExitSuccess
+	case *ForCmd:
+		return sh.runFor(c, st)
+	case *WhileCmd:
+		return sh.runWhile(c, st)
+	case *CaseCmd:
+		return sh.runCase(c, st)
+	case *FuncDef:
+		sh.funcs[c.Name] = c.Body
+		return utils.ExitSuccess
+	}
+	return utils.ExitSuccess
+}
+
+func (sh *Shell) runFor(c *ForCmd, st stdio) int {
+	items := sh.args
+	if c.HasIn {
+		items = nil
+		for _, w := range c.Items {
+			f, err := sh.expandFields(w)
+			if err != nil {
+				return sh.expansionError(err, st)
+			}
+			items = append(items, f...)
+		}
+	}
+	status := utils.ExitSuccess
+	for _, it := range items {
+		sh.setVar(c.Var, it)
+		status = sh.runList(c.Body, st)
+		if sh.loopControl() {
+			break
+		}
+	}
+	return status
+}
+
+func (sh *Shell) runWhile(c *WhileCmd, st stdio) int {
+	status := utils.ExitSuccess
+	for {
+		cond := sh.runCondition(c.Cond, st)
+		if sh.stopped() && sh.loopControl() {
+			break
+		}
+		if (cond == utils.ExitSuccess) == c.Until {
+			break
+		}
+		status = sh.runList(c.Body, st)
+		if sh.loopControl() {
+			break
+		}
+	}
+	return status
+}
+
+// loopControl consumes one level of break/continue and reports whether
+// the enclosing loop must stop.
+func (sh *Shell) loopControl() bool {
+	if sh.breakN > 0 {
+		sh.breakN--
+		return true
+	}
+	if sh.continueN > 0 {
+		sh.continueN--
+		return sh.continueN > 0
+	}
+	return sh.exiting || sh.returning || sh.ctx.Canceled()
+}
+
+func (sh *Shell) runCase(c *CaseCmd, st stdio) int {
+	word, err := sh.expandString(c.Word)
+	if err != nil {
+		return sh.expansionError(err, st)
+	}
+	for _, item := range c.Items {
+		for _, p := range item.Patterns {
+			pat, err := sh.expandPattern(p)
+			if err != nil {
+				return sh.expansionError(err, st)
+			}
+			if ok, _ := glob.Match(pat, word); ok {
+				return sh.runList(item.Body, st)
+			}
+		}
+	}
+	return utils.ExitSuccess
+}
+
+func (sh *Shell) expansionError(err error, st stdio) int {
+	fmt.Fprintf(st.err, "sh: %v\n", err)
+	var fe *fatalError
+	if errors.As(err, &fe) && !sh.interactive {
+		sh.exiting = true
+	}
+	return utils.ExitFailure
+}
+
+func (sh *Shell) runSimple(c *SimpleCmd, st stdio) int {
+	var argv []string
+	for _, w := range c.Words {
+		f, err := sh.expandFields(w)
+		if err != nil {
+			return sh.expansionError(err, st)
+		}
+		argv = append(argv, f...)
+	}
+
+	if len(argv) > 0 {
+		if alias, ok := sh.aliases[argv[0]]; ok {
+			words, err := sh.aliasWords(alias)
+			if err != nil {
+				return sh.expansionError(err, st)
+			}
+			argv = append(words, argv[1:]...)
+		}
+	}
+
+	sh.substituted = false
+	assigns := make([]string, 0, len(c.Assigns))
+	for _, a := range c.Assigns {
+		v, err := sh.expandString(a.Value)
+		if err != nil {
+			return sh.expansionError(err, st)
+		}
+		assigns = append(assigns, a.Name+"="+v)
+	}
+
+	if sh.opts.xtrace {
+		trace := append(append([]string(nil), assigns...), argv...)
+		fmt.Fprintf(st.err, "+ %s\n", strings.Join(trace, " "))
+	}
+
+	rio, closeAll, err := sh.redirect(c.Redirs, st)
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: %v\n", err)
+		return utils.ExitFailure
+	}
+	defer closeAll()
+
+	// Bare assignments change the shell itself.
+	if len(argv) == 0 {
+		for _, kv := range assigns {
+			k, v, _ := strings.Cut(kv, "=")
+			sh.setVar(k, v)
+		}
+		// The status is that of the last command substitution, if any.
+		if !sh.substituted {
+			sh.status = utils.ExitSuccess
+		}
+		return sh.status
+	}
+
+	name := argv[0]
+	if body, ok := sh.funcs[name]; ok {
+		restore := sh.tempAssign(assigns)
+		defer restore()
+		return sh.callFunction(body, argv, rio)
+	}
+	if b, ok := builtins[name]; ok {
+		restore := sh.tempAssign(assigns)
+		defer restore()
+		return b(sh, rio, argv)
+	}
+	return sh.runExternal(argv, assigns, rio)
+}
+
+// aliasWords splits an alias value into words.
+func (sh *Shell) aliasWords(alias string) ([]string, error) {
+	l := newLexer(alias)
+	var words []string
+	for {
+		t, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokEOF {
+			return words, nil
+		}
+		f, err := sh.expandFields(t.text)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, f...)
+	}
+}
+
+// tempAssign applies prefix assignments for the duration of a builtin
+// or function call.
+func (sh *Shell) tempAssign(assigns []string) func() {
+	if len(assigns) == 0 {
+		return func() {}
+	}
+	saved := make(map[string]*variable)
+	for _, kv := range assigns {
+		k, v, _ := strings.Cut(kv, "=")
+		if _, done := saved[k]; !done {
+			saved[k] = sh.vars[k]
+		}
+		sh.vars[k] = &variable{value: v, exported: true}
+	}
+	return func() {
+		for k, v := range saved {
+			if v == nil {
+				delete(sh.vars, k)
+			} else {
+				sh.vars[k] = v
+			}
+		}
+	}
+}
+
+func (sh *Shell) callFunction(body Command, argv []string, st stdio) int {
+	savedArgs := sh.args
+	sh.args = argv[1:]
+	sh.locals = append(sh.locals, make(map[string]*variable))
+	defer func() {
+		frame := sh.locals[len(sh.locals)-1]
+		sh.locals = sh.locals[:len(sh.locals)-1]
+		for k, v := range frame {
+			if v == nil {
+				delete(sh.vars, k)
+			} else {
+				sh.vars[k] = v
+			}
+		}
+		sh.args = savedArgs
+	}()
+
+	status := sh.runCommand(body, st)
+	if sh.returning {
+		sh.returning = false
+		status = sh.status
+	}
+	return status
+}
+
+// runExternal runs a winux command in-process, or a program or script
+// found on PATH.
+func (sh *Shell) runExternal(argv, assigns []string, st stdio) int {
+	name := argv[0]
+	env := sh.environ(assigns)
+
+	if !strings.ContainsAny(name, `/\`) {
+		cmd, args, err := core.Lookup(sh.ctx, argv)
+		if err == nil {
+			ctx := sh.ctx.Clone()
+			ctx.Stdin, ctx.Stdout, ctx.Stderr = st.in, st.out, st.err
+			ctx.Dir = sh.dir
+			ctx.Env = env
+			return cmd.Run(ctx, args)
+		}
+		if !errors.Is(err, core.ErrNotFound) {
+			fmt.Fprintf(st.err, "sh: %v\n", err)
+			return utils.ExitCommandNotFound
+		}
+	}
+
+	path, err := sh.lookPath(name)
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: %s: command not found\n", name)
+		return utils.ExitCommandNotFound
+	}
+
+	// Run shell scripts with this shell; Windows cannot execute them.
+	if runtime.GOOS == "windows" && sh.isScript(path) {
+		ctx := sh.ctx.Clone()
+		ctx.Stdin, ctx.Stdout, ctx.Stderr = st.in, st.out, st.err
+		ctx.Dir = sh.dir
+		ctx.Env = env
+		sub := New(ctx)
+		sub.SetArgs(name, argv[1:])
+		return sub.RunFile(path)
+	}
+
+	cmd := exec.CommandContext(sh.ctx.Context, path, argv[1:]...)
+	cmd.Args[0] = name
+	cmd.Dir = sh.dir
+	cmd.Env = env
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = st.in, st.out, st.err
+	if err := cmd.Run(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return ee.ExitCode()
+		}
+		fmt.Fprintf(st.err, "sh: %s: %v\n", name, errText(err))
+		return 126
+	}
+	return utils.ExitSuccess
+}
+
+// isScript reports whether path is a shell script: a .sh file or a
+// file starting with a #! line whose interpreter is sh or bash, run
+// directly or through env.
+func (sh *Shell) isScript(path string) bool {
+	if strings.EqualFold(filepath.Ext(path), ".sh") {
+		return true
+	}
+	f, err := sh.ctx.Files().Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	head := make([]byte, 128)
+	n, _ := f.Read(head)
+	line, ok := strings.CutPrefix(string(head[:n]), "#!")
+	if !ok {
+		return false
+	}
+	line, _, _ = strings.Cut(line, "\n")
+	args := strings.Fields(line)
+	if len(args) > 0 && filepath.Base(args[0]) == "env" {
+		args = args[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+			args = args[1:]
+		}
+	}
+	if len(args) == 0 {
+		return false
+	}
+	interp := filepath.Base(args[0])
+	return interp == "sh" || interp == "bash"
+}
+
+// lookPath finds an executable using the shell's PATH.
+func (sh *Shell) lookPath(name string) (string, error) {
+	exts := []string{""}
+	if runtime.GOOS == "windows" {
+		exts = append(exts, strings.Split(strings.ToLower(firstNonEmpty(sh.getenv("PATHEXT"), ".com;.exe;.bat;.cmd")), ";")...)
+		exts = append(exts, ".sh")
+	}
+
+	try := func(p string) (string, bool) {
+		for _, ext := range exts {
+			fi, err := sh.ctx.Files().Stat(p + ext)
+			if err == nil && !fi.IsDir() && (runtime.GOOS == "windows" || fi.Mode()&0111 != 0) {
+				return p + ext, true
+			}
+		}
+		return "", false
+	}
+
+	if strings.ContainsAny(name, `/\`) {
+		if p, ok := try(sh.path(name)); ok {
+			return p, nil
+		}
+		return "", os.ErrNotExist
+	}
+	for _, dir := range filepath.SplitList(sh.getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		if p, ok := try(filepath.Join(sh.path(dir), name)); ok {
+			return p, nil
+		}
+	}
+	return "", exec.ErrNotFound
+}
+
+// redirect applies redirections to st and returns the new streams and
+// a function closing any files opened.
+func (sh *Shell) redirect(redirs []*Redir, st stdio) (stdio, func(), error) {
+	var files []vfs.File
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	for _, r := range redirs {
+		var target interface{}
+		switch r.Op {
+		case "<<", "<<-":
+			body := r.Body
+			if !r.Quoted {
+				var err error
+				body, err = sh.expandHeredoc(body)
+				if err != nil {
+					closeAll()
+					return st, nil, err
+				}
+			}
+			target = strings.NewReader(body)
+		case "<&", ">&":
+			word, err := sh.expandString(r.Word)
+			if err != nil {
+				closeAll()
+				return st, nil, err
+			}
+			switch word {
+			case "0":
+				target = st.in
+			case "1":
+				target = st.out
+			case "2":
+				target = st.err
+			case "-":
+				if r.Op == "<&" {
+					target = strings.NewReader("")
+				} else {
+					target = discard{}
+				}
+			default:
+				if r.Op == ">&" && !isDigits(word) {
+					// >&file is &>file.
+					f, err := sh.ctx.Files().OpenFile(sh.path(word), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+					if err != nil {
+						closeAll()
+						return st, nil, fmt.Errorf("%s: %s", word, errText(err))
+					}
+					files = append(files, f)
+					st.out, st.err = f, f
+					continue
+				}
+				closeAll()
+				return st, nil, fmt.Errorf("%s: bad file descriptor", word)
+			}
+		default:
+			word, err := sh.expandString(r.Word)
+			if err != nil {
+				closeAll()
+				return st, nil, err
+			}
+			flag := os.O_RDONLY
+			switch r.Op {
+			case ">", ">|", "&>":
+				flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+			case ">>":
+				flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+			case "<>":
+				flag = os.O_RDWR | os.O_CREATE
+			}
+			f, err := sh.ctx.Files().OpenFile(sh.path(word), flag, 0644)
+			if err != nil {
+				closeAll()
+				return st, nil, fmt.Errorf("%s: %s", word, errText(err))
+			}
+			files = append(files, f)
+			if r.Op == "&>" {
+				st.out, st.err = f, f
+				continue
+			}
+			target = f
+		}
+
+		switch r.Fd {
+		case 0:
+			if rd, ok := target.(io.Reader); ok {
+				st.in = rd
+			}
+		case 1:
+			if w, ok := target.(io.Writer); ok {
+				st.out = w
+			}
+		case 2:
+			if w, ok := target.(io.Writer); ok {
+				st.err = w
+			}
+		}
+	}
+	return st, closeAll, nil
+}
+
+type discard struct{}
+
+func (discard) Write(p []byte) (int, error) { return len(p), nil }
+
+// expandHeredoc expands $, ` and \ in an unquoted here-document body.
+func (sh *Shell) expandHeredoc(body string) (string, error) {
+	e := &expander{sh: sh}
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			e.lit(lit.String(), true)
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(body); {
+		switch c := body[i]; {
+		case c == '\\' && i+1 < len(body) && strings.IndexByte("$`\\\n", body[i+1]) >= 0:
+			if body[i+1] != '\n' {
+				lit.WriteByte(body[i+1])
+			}
+			i += 2
+		case c == '$' || c == '`':
+			flush()
+			var n int
+			var err error
+			if c == '$' {
+				n, err = e.dollar(body[i:], true)
+			} else {
+				n, err = e.backtick(body[i:], true)
+			}
+			if err != nil {
+				return "", err
+			}
+			i += n
+		default:
+			lit.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	var b strings.Builder
+	for _, f := range e.fields {
+		b.WriteString(f.val.String())
+	}
+	return b.String(), nil
+}
+
+// runInterruptible runs list, canceling the running command rather
+// than the shell on Ctrl+C.
+func (sh *Shell) runInterruptible(list *List, st stdio) {
+	parent := sh.ctx
+	ctx, cancel := context.WithCancel(parent.Context)
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-done:
+		}
+	}()
+
+	sh.ctx = parent.WithContext(ctx)
+	sh.runList(list, st)
+	sh.ctx = parent
+
+	close(done)
+	signal.Stop(sigs)
+	cancel()
+}
@@ -0,0 +1,619 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/glob"
+)
+
+// field is one word under construction during expansion.
+type field struct {
+	val    strings.Builder // expanded value
+	pat    strings.Builder // glob pattern, with quoted characters escaped
+	glob   bool            // contains unquoted glob characters
+	quoted bool            // contains a quoted part, so keep it even if empty
+}
+
+// expander turns a raw word into fields.
+type expander struct {
+	sh     *Shell
+	split  bool // perform field splitting and globbing
+	fields []*field
+	cur    *field
+}
+
+func (e *expander) field() *field {
+	if e.cur == nil {
+		e.cur = &field{}
+		e.fields = append(e.fields, e.cur)
+	}
+	return e.cur
+}
+
+// lit appends text that came from the source word itself.
+func (e *expander) lit(s string, quoted bool) {
+	f := e.field()
+	if quoted {
+		f.quoted = true
+	}
+	f.val.WriteString(s)
+	if quoted {
+		f.pat.WriteString(glob.QuoteMeta(s))
+		return
+	}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*', '?', '[':
+			f.glob = true
+		case '\\', ']':
+			f.pat.WriteByte('\\')
+		}
+		f.pat.WriteByte(s[i])
+	}
+}
+
+// expansion appends the result of a parameter, command or arithmetic
+// expansion, splitting it on IFS when unquoted.
+func (e *expander) expansion(s string, quoted bool) {
+	if quoted || !e.split {
+		e.lit(s, true)
+		if !quoted {
+			e.field().quoted = false
+		}
+		return
+	}
+	ifs, ok := e.sh.lookup("IFS")
+	if !ok {
+		ifs = " \t\n"
+	}
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(ifs, s[i]) < 0 {
+			continue
+		}
+		if i > start {
+			e.lit(s[start:i], false)
+		}
+		if e.cur == nil && !strings.ContainsRune(" \t\n", rune(s[i])) {
+			e.field().quoted = true
+		}
+		e.cur = nil
+		start = i + 1
+	}
+	if start < len(s) {
+		e.lit(s[start:], false)
+	}
+}
+
+// newField forces the start of a separate field, for "$@".
+func (e *expander) newField() {
+	e.cur = nil
+	e.field().quoted = true
+}
+
+// expandFields expands a word into zero or more fields with splitting
+// and filename generation.
+func (sh *Shell) expandFields(word string) ([]string, error) {
+	e := &expander{sh: sh, split: true}
+	if err := e.word(word); err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, f := range e.fields {
+		if f.glob && !sh.opts.noglob {
+			matches, err := glob.Glob(sh.ctx.Files(), sh.dir, f.pat.String())
+			if err == nil && len(matches) > 0 {
+				out = append(out, matches...)
+				continue
+			}
+		}
+		if f.val.Len() == 0 && !f.quoted {
+			continue
+		}
+		out = append(out, f.val.String())
+	}
+	return out, nil
+}
+
+// expandString expands a word into a single string without splitting
+// or globbing, as for assignments and redirection targets.
+func (sh *Shell) expandString(word string) (string, error) {
+	e := &expander{sh: sh}
+	if err := e.word(word); err != nil {
+		return "", err
+	}
+	parts := make([]string, len(e.fields))
+	for i, f := range e.fields {
+		parts[i] = f.val.String()
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// expandPattern expands a word for use as a case or ${x#...} pattern,
+// keeping quoted characters literal.
+func (sh *Shell) expandPattern(word string) (string, error) {
+	e := &expander{sh: sh}
+	if err := e.word(word); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, f := range e.fields {
+		b.WriteString(f.pat.String())
+	}
+	return b.String(), nil
+}
+
+func (e *expander) word(w string) error {
+	for i := 0; i < len(w); {
+		c := w[i]
+		switch {
+		case c == '\'':
+			end := strings.IndexByte(w[i+1:], '\'')
+			if end < 0 {
+				end = len(w) - i - 1
+			}
+			e.lit(w[i+1:i+1+end], true)
+			i += end + 2
+		case c == '"':
+			n, err := e.double(w[i+1:])
+			if err != nil {
+				return err
+			}
+			i += n + 1
+		case c == '\\':
+			if i+1 < len(w) {
+				e.lit(w[i+1:i+2], true)
+			}
+			i += 2
+		case c == '$':
+			n, err := e.dollar(w[i:], false)
+			if err != nil {
+				return err
+			}
+			i += n
+		case c == '`':
+			n, err := e.backtick(w[i:], false)
+			if err != nil {
+				return err
+			}
+			i += n
+		case c == '~' && i == 0:
+			end := strings.IndexByte(w, '/')
+			if end < 0 {
+				end = len(w)
+			}
+			if home, ok := e.sh.tilde(w[1:end]); ok {
+				e.lit(home, true)
+				i = end
+				continue
+			}
+			e.lit("~", false)
+			i++
+		default:
+			j := i + 1
+			for j < len(w) && strings.IndexByte(`'"\$`+"`", w[j]) < 0 {
+				j++
+			}
+			e.lit(w[i:j], false)
+			i = j
+		}
+	}
+	return nil
+}
+
+// double expands the inside of a double-quoted string and returns the
+// number of bytes consumed including the closing quote.
+func (e *expander) double(w string) (int, error) {
+	e.field().quoted = true
+	if strings.HasPrefix(w, `$@"`) && e.split {
+		e.cur.quoted = len(e.sh.args) > 0 || e.cur.val.Len() > 0
+		for i, a := range e.sh.args {
+			if i > 0 {
+				e.newField()
+			}
+			e.lit(a, true)
+		}
+		return 3, nil
+	}
+
+	i := 0
+	for i < len(w) {
+		c := w[i]
+		switch c {
+		case '"':
+			return i + 1, nil
+		case '\\':
+			if i+1 < len(w) && strings.IndexByte("$`\"\\\n", w[i+1]) >= 0 {
+				if w[i+1] != '\n' {
+					e.lit(w[i+1:i+2], true)
+				}
+				i += 2
+				continue
+			}
+			e.lit(`\`, true)
+			i++
+		case '$':
+			n, err := e.dollar(w[i:], true)
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		case '`':
+			n, err := e.backtick(w[i:], true)
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		default:
+			j := i + 1
+			for j < len(w) && strings.IndexByte("\"\\$`", w[j]) < 0 {
+				j++
+			}
+			e.lit(w[i:j], true)
+			i = j
+		}
+	}
+	return i, nil
+}
+
+// dollar expands a $ expression at the start of w and returns the
+// number of bytes consumed.
+func (e *expander) dollar(w string, quoted bool) (int, error) {
+	if len(w) < 2 {
+		e.lit("$", quoted)
+		return 1, nil
+	}
+	switch c := w[1]; {
+	case c == '(':
+		end := matchParen(w, 1)
+		if end < 0 {
+			return 0, errors.New("unterminated command substitution")
+		}
+		if strings.HasPrefix(w, "$((") && w[end-1] == ')' {
+			expr, err := e.sh.expandString(w[3 : end-1])
+			if err != nil {
+				return 0, err
+			}
+			n, err := e.sh.arith(expr)
+			if err != nil {
+				return 0, err
+			}
+			e.expansion(strconv.FormatInt(n, 10), quoted)
+			return end + 1, nil
+		}
+		e.expansion(e.sh.substitute(w[2:end]), quoted)
+		return end + 1, nil
+	case c == '{':
+		end := matchBrace(w, 1)
+		if end < 0 {
+			return 0, errors.New("bad substitution")
+		}
+		if err := e.braced(w[2:end], quoted); err != nil {
+			return 0, err
+		}
+		return end + 1, nil
+	case c == '@' || c == '*':
+		if c == '@' && quoted {
+			// "..$@.." outside the simple "$@" form: one field per argument.
+			for i, a := range e.sh.args {
+				if i > 0 && e.split {
+					e.newField()
+				} else if i > 0 {
+					e.lit(" ", true)
+				}
+				e.lit(a, true)
+			}
+			return 2, nil
+		}
+		if quoted {
+			e.expansion(strings.Join(e.sh.args, e.sh.ifsJoin()), true)
+			return 2, nil
+		}
+		for i, a := range e.sh.args {
+			if i > 0 {
+				e.cur = nil
+			}
+			e.expansion(a, false)
+		}
+		return 2, nil
+	case strings.IndexByte("?#$!-0123456789", c) >= 0:
+		v, err := e.sh.param(string(c))
+		if err != nil {
+			return 0, err
+		}
+		e.expansion(v, quoted)
+		return 2, nil
+	case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		j := 2
+		for j < len(w) && isName(w[1:j+1]) {
+			j++
+		}
+		v, err := e.sh.param(w[1:j])
+		if err != nil {
+			return 0, err
+		}
+		e.expansion(v, quoted)
+		return j, nil
+	}
+	e.lit("$", quoted)
+	return 1, nil
+}
+
+// braced handles ${...} forms.
+func (e *expander) braced(expr string, quoted bool) error {
+	sh := e.sh
+	if strings.HasPrefix(expr, "#") && len(expr) > 1 {
+		v, err := sh.param(expr[1:])
+		if err != nil {
+			return err
+		}
+		e.expansion(strconv.Itoa(len([]rune(v))), quoted)
+		return nil
+	}
+
+	// Split NAME from the operator.
+	n := 0
+	if n < len(expr) && strings.IndexByte("?#$!-*@", expr[0]) >= 0 {
+		n = 1
+	} else {
+		for n < len(expr) && (isName(expr[:n+1]) || (expr[n] >= '0' && expr[n] <= '9' && isDigits(expr[:n+1]))) {
+			n++
+		}
+	}
+	if n == 0 {
+		return fmt.Errorf("${%s}: bad substitution", expr)
+	}
+	name, op := expr[:n], expr[n:]
+
+	if op == "" {
+		if name == "@" || name == "*" {
+			return e.dollarArgs(name, quoted)
+		}
+		v, err := sh.param(name)
+		if err != nil {
+			return err
+		}
+		e.expansion(v, quoted)
+		return nil
+	}
+
+	v, set := sh.lookupParam(name)
+	colon := strings.HasPrefix(op, ":") && len(op) > 1 && strings.IndexByte("-=+?", op[1]) >= 0
+	if colon {
+		op = op[1:]
+		if v == "" {
+			set = false
+		}
+	}
+
+	switch op[0] {
+	case '-', '=', '+', '?':
+		arg, err := sh.expandString(op[1:])
+		if err != nil {
+			return err
+		}
+		switch op[0] {
+		case '-':
+			if !set {
+				v = arg
+			}
+		case '=':
+			if !set {
+				if !isName(name) {
+					return fmt.Errorf("$%s: cannot assign in this way", name)
+				}
+				sh.setVar(name, arg)
+				v = arg
+			}
+		case '+':
+			if set {
+				v = arg
+			} else {
+				v = ""
+			}
+		case '?':
+			if !set {
+				if arg == "" {
+					arg = "parameter null or not set"
+				}
+				return &fatalError{fmt.Sprintf("%s: %s", name, arg)}
+			}
+		}
+		e.expansion(v, quoted)
+		return nil
+	case '#', '%':
+		longest := len(op) > 1 && op[1] == op[0]
+		pat := op[1:]
+		if longest {
+			pat = op[2:]
+		}
+		p, err := sh.expandPattern(pat)
+		if err != nil {
+			return err
+		}
+		e.expansion(trimPattern(v, p, op[0] == '#', longest), quoted)
+		return nil
+	case '/':
+		all := strings.HasPrefix(op, "//")
+		rest := op[1:]
+		if all {
+			rest = op[2:]
+		}
+		pat, rep := rest, ""
+		if i := indexUnquoted(rest, '/'); i >= 0 {
+			pat, rep = rest[:i], rest[i+1:]
+		}
+		p, err := sh.expandPattern(pat)
+		if err != nil {
+			return err
+		}
+		r, err := sh.expandString(rep)
+		if err != nil {
+			return err
+		}
+		e.expansion(replacePattern(v, p, r, all), quoted)
+		return nil
+	}
+	return fmt.Errorf("${%s}: bad substitution", expr)
+}
+
+func (e *expander) dollarArgs(name string, quoted bool) error {
+	w := "$" + name
+	_, err := e.dollar(w, quoted)
+	return err
+}
+
+// backtick expands `cmd` and returns the bytes consumed.
+func (e *expander) backtick(w string, quoted bool) (int, error) {
+	var src strings.Builder
+	for i := 1; i < len(w); i++ {
+		switch w[i] {
+		case '`':
+			e.expansion(e.sh.substitute(src.String()), quoted)
+			return i + 1, nil
+		case '\\':
+			if i+1 < len(w) && strings.IndexByte("$`\\", w[i+1]) >= 0 {
+				i++
+			}
+		}
+		src.WriteByte(w[i])
+	}
+	return 0, errors.New("unterminated command substitution")
+}
+
+// matchParen returns the index of the ')' matching the '(' at w[open].
+func matchParen(w string, open int) int {
+	l := &lexer{src: w, pos: open}
+	if err := l.scanParens(); err != nil {
+		return -1
+	}
+	return l.pos - 1
+}
+
+// matchBrace returns the index of the '}' matching the '{' at w[open].
+func matchBrace(w string, open int) int {
+	l := &lexer{src: w, pos: open - 1}
+	if err := l.scanDollar(); err != nil {
+		return -1
+	}
+	return l.pos - 1
+}
+
+// indexUnquoted returns the index of the first c in w that is neither
+// escaped with a backslash nor quoted, or -1.
+func indexUnquoted(w string, c byte) int {
+	var quote byte
+	for i := 0; i < len(w); i++ {
+		switch {
+		case quote != 0:
+			if w[i] == quote {
+				quote = 0
+			} else if w[i] == '\\' && quote == '"' {
+				i++
+			}
+		case w[i] == '\\':
+			i++
+		case w[i] == '\'' || w[i] == '"':
+			quote = w[i]
+		case w[i] == c:
+			return i
+		}
+	}
+	return -1
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// trimPattern implements ${v#p}, ${v##p}, ${v%p} and ${v%%p}.
+func trimPattern(v, pat string, prefix, longest bool) string {
+	if prefix {
+		if longest {
+			for i := len(v); i >= 0; i-- {
+				if ok, _ := glob.Match(pat, v[:i]); ok {
+					return v[i:]
+				}
+			}
+		} else {
+			for i := 0; i <= len(v); i++ {
+				if ok, _ := glob.Match(pat, v[:i]); ok {
+					return v[i:]
+				}
+			}
+		}
+		return v
+	}
+	if longest {
+		for i := 0; i <= len(v); i++ {
+			if ok, _ := glob.Match(pat, v[i:]); ok {
+				return v[:i]
+			}
+		}
+	} else {
+		for i := len(v); i >= 0; i-- {
+			if ok, _ := glob.Match(pat, v[i:]); ok {
+				return v[:i]
+			}
+		}
+	}
+	return v
+}
+
+// replacePattern implements ${v/p/r} and ${v//p/r} with longest matches.
+func replacePattern(v, pat, rep string, all bool) string {
+	var b strings.Builder
+	for i := 0; i < len(v); {
+		end := -1
+		for j := len(v); j > i; j-- {
+			if ok, _ := glob.Match(pat, v[i:j]); ok {
+				end = j
+				break
+			}
+		}
+		if end < 0 {
+			b.WriteByte(v[i])
+			i++
+			continue
+		}
+		b.WriteString(rep)
+		i = end
+		if !all {
+			b.WriteString(v[i:])
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// tilde expands ~ and ~user prefixes.
+func (sh *Shell) tilde(user string) (string, bool) {
+	if user != "" {
+		return "", false
+	}
+	if home, ok := sh.lookup("HOME"); ok && home != "" {
+		return home, true
+	}
+	home, err := os.UserHomeDir()
+	return home, err == nil
+}
+
+// substitute runs src in a subshell and returns its output without
+// trailing newlines.
+func (sh *Shell) substitute(src string) string {
+	var out strings.Builder
+	sub := sh.subshell()
+	sub.status = sub.runSource(src, stdio{in: sh.ctx.Stdin, out: &out, err: sh.ctx.Stderr})
+	sh.status = sub.status
+	sh.substituted = true
+	return strings.TrimRight(out.String(), "\r\n")
+}
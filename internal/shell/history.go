@@ -0,0 +1,68 @@
+package shell
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxHistory bounds the history kept in memory and on disk.
+const maxHistory = 1000
+
+// historyFile returns $HISTFILE, defaulting to ~/.winux_history.
+func (sh *Shell) historyFile() string {
+	if f, ok := sh.lookup("HISTFILE"); ok {
+		return f
+	}
+	home := sh.getenv("HOME")
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".winux_history")
+}
+
+func (sh *Shell) loadHistory() {
+	path := sh.historyFile()
+	if path == "" {
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if line := sc.Text(); line != "" {
+			sh.history = append(sh.history, line)
+		}
+	}
+	if len(sh.history) > maxHistory {
+		sh.history = sh.history[len(sh.history)-maxHistory:]
+	}
+}
+
+// addHistory records an entered command and appends it to the
+// history file. Multi-line commands are stored on one line.
+func (sh *Shell) addHistory(cmd string) {
+	cmd = strings.ReplaceAll(cmd, "\n", "; ")
+	if n := len(sh.history); n > 0 && sh.history[n-1] == cmd {
+		return
+	}
+	sh.history = append(sh.history, cmd)
+	if len(sh.history) > maxHistory {
+		sh.history = sh.history[1:]
+	}
+
+	path := sh.historyFile()
+	if path == "" {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	f.WriteString(cmd + "\n")
+}
@@ -0,0 +1,127 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// job is a background and-or list started with '&'. Jobs run in
+// goroutines, so pid is a process id made up for $! and wait; it counts
+// up from the shell's own.
+type job struct {
+	id     int
+	pid    int
+	text   string
+	done   chan struct{}
+	status int
+}
+
+// jobTable tracks background jobs; it is shared with subshells.
+type jobTable struct {
+	mu   sync.Mutex
+	list []*job
+	next int
+}
+
+func (t *jobTable) add(text string) *job {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next++
+	j := &job{id: t.next, pid: os.Getpid() + t.next, text: text, done: make(chan struct{})}
+	t.list = append(t.list, j)
+	return j
+}
+
+// lastPid returns the pid of the most recent background job, or 0.
+func (t *jobTable) lastPid() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.list) == 0 {
+		return 0
+	}
+	return t.list[len(t.list)-1].pid
+}
+
+func (t *jobTable) snapshot() []*job {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*job(nil), t.list...)
+}
+
+func (t *jobTable) remove(j *job) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, x := range t.list {
+		if x == j {
+			t.list = append(t.list[:i], t.list[i+1:]...)
+			return
+		}
+	}
+}
+
+// runBackground starts ao in a subshell without waiting for it.
+func (sh *Shell) runBackground(ao *AndOr, st stdio) {
+	j := sh.jobs.add(ao.Text)
+	sub := sh.subshell()
+	go func() {
+		j.status = sub.runAndOr(ao, st)
+		close(j.done)
+	}()
+	if sh.interactive {
+		fmt.Fprintf(st.err, "[%d] %d\n", j.id, j.pid)
+	}
+	sh.status = utils.ExitSuccess
+}
+
+func builtinJobs(sh *Shell, st stdio, argv []string) int {
+	for _, j := range sh.jobs.snapshot() {
+		state := "Running"
+		select {
+		case <-j.done:
+			state = "Done"
+			if j.status != utils.ExitSuccess {
+				state = fmt.Sprintf("Exit %d", j.status)
+			}
+		default:
+		}
+		fmt.Fprintf(st.out, "[%d]  %-24s%s\n", j.id, state, j.text)
+	}
+	return utils.ExitSuccess
+}
+
+// builtinWait waits for the given jobs (%N) or pids, or for all of them.
+// Without operands it returns 0, as POSIX requires.
+func builtinWait(sh *Shell, st stdio, argv []string) int {
+	jobs := sh.jobs.snapshot()
+	if len(argv) < 2 {
+		for _, j := range jobs {
+			<-j.done
+			sh.jobs.remove(j)
+		}
+		return utils.ExitSuccess
+	}
+
+	status := utils.ExitSuccess
+	for _, a := range argv[1:] {
+		spec := strings.HasPrefix(a, "%")
+		n, err := strconv.Atoi(strings.TrimPrefix(a, "%"))
+		if err != nil {
+			fmt.Fprintf(st.err, "sh: wait: %s: not a pid or job id\n", a)
+			return utils.ExitUsageError
+		}
+		status = 127
+		for _, j := range jobs {
+			if (spec && j.id == n) || (!spec && j.pid == n) {
+				<-j.done
+				status = j.status
+				sh.jobs.remove(j)
+			}
+		}
+	}
+	return status
+}
@@ -0,0 +1,322 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrIncomplete is returned when the input ends in the middle of a
+// construct; interactive mode reads another line and tries again.
+var ErrIncomplete = errors.New("unexpected end of input")
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokNewline
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string // raw word text, quotes included, or the operator
+	fd   int    // explicit descriptor of a redirection operator, or -1
+	line int
+	pos  int // offset of the token in the source
+	end  int // offset just past the token
+}
+
+// operators lists shell operators longest first so the lexer is greedy.
+var operators = []string{
+	"<<-", "&&", "||", ";;", "<<", ">>", "<&", ">&", "&>", "<>", ">|",
+	"|", "&", ";", "(", ")", "<", ">",
+}
+
+// lexer splits shell source into tokens. Words keep their quoting;
+// expansion interprets it later.
+type lexer struct {
+	src  string
+	pos  int
+	line int
+
+	// Here-documents waiting for their bodies, read after the next newline.
+	pending      []*Redir
+	afterNewline bool
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1}
+}
+
+func (l *lexer) next() (token, error) {
+	if l.afterNewline && len(l.pending) > 0 {
+		if err := l.readHeredocs(); err != nil {
+			return token{}, err
+		}
+	}
+	l.afterNewline = false
+
+	// Skip blanks, comments and line continuations.
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == ' ' || c == '\t' || c == '\r' {
+			l.pos++
+		} else if c == '\\' && l.pos+1 < len(l.src) && l.src[l.pos+1] == '\n' {
+			l.pos += 2
+			l.line++
+		} else if c == '#' {
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		} else {
+			break
+		}
+	}
+
+	if l.pos >= len(l.src) {
+		if len(l.pending) > 0 {
+			return token{}, ErrIncomplete
+		}
+		return token{kind: tokEOF, line: l.line, pos: l.pos, end: l.pos}, nil
+	}
+
+	c := l.src[l.pos]
+	if c == '\n' {
+		l.pos++
+		l.line++
+		l.afterNewline = true
+		return token{kind: tokNewline, text: "\n", line: l.line - 1, pos: l.pos - 1, end: l.pos}, nil
+	}
+
+	// An all-digit word directly followed by < or > is a descriptor.
+	fd := -1
+	opStart := l.pos
+	if c >= '0' && c <= '9' {
+		end := l.pos
+		for end < len(l.src) && l.src[end] >= '0' && l.src[end] <= '9' {
+			end++
+		}
+		if end < len(l.src) && (l.src[end] == '<' || l.src[end] == '>') {
+			fmt.Sscan(l.src[l.pos:end], &fd)
+			l.pos = end
+		}
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, text: op, fd: fd, line: l.line, pos: opStart, end: l.pos}, nil
+		}
+	}
+
+	start := l.pos
+	line := l.line
+	if err := l.scanWord(); err != nil {
+		return token{}, err
+	}
+	return token{kind: tokWord, text: l.src[start:l.pos], fd: -1, line: line, pos: start, end: l.pos}, nil
+}
+
+// scanWord advances over one word, honouring quotes and substitutions.
+func (l *lexer) scanWord() error {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch c {
+		case ' ', '\t', '\r', '\n', ';', '&', '|', '(', ')', '<', '>':
+			return nil
+		case '\\':
+			l.pos += 2
+			if l.pos > len(l.src) {
+				l.pos = len(l.src)
+			}
+		case '\'':
+			end := strings.IndexByte(l.src[l.pos+1:], '\'')
+			if end < 0 {
+				return ErrIncomplete
+			}
+			l.line += strings.Count(l.src[l.pos:l.pos+end+2], "\n")
+			l.pos += end + 2
+		case '"':
+			l.pos++
+			if err := l.scanDouble(); err != nil {
+				return err
+			}
+		case '`':
+			l.pos++
+			if err := l.scanBacktick(); err != nil {
+				return err
+			}
+		case '$':
+			if err := l.scanDollar(); err != nil {
+				return err
+			}
+		default:
+			l.pos++
+		}
+	}
+	return nil
+}
+
+func (l *lexer) scanDouble() error {
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '"':
+			l.pos++
+			return nil
+		case '\\':
+			l.pos += 2
+		case '`':
+			l.pos++
+			if err := l.scanBacktick(); err != nil {
+				return err
+			}
+		case '$':
+			if err := l.scanDollar(); err != nil {
+				return err
+			}
+		case '\n':
+			l.line++
+			l.pos++
+		default:
+			l.pos++
+		}
+	}
+	return ErrIncomplete
+}
+
+func (l *lexer) scanBacktick() error {
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '`':
+			l.pos++
+			return nil
+		case '\\':
+			l.pos += 2
+		default:
+			l.pos++
+		}
+	}
+	return ErrIncomplete
+}
+
+// scanDollar skips $name, ${...}, $(...) and $((...)).
+func (l *lexer) scanDollar() error {
+	l.pos++
+	if l.pos >= len(l.src) {
+		return nil
+	}
+	switch l.src[l.pos] {
+	case '{':
+		depth := 0
+		for l.pos < len(l.src) {
+			switch l.src[l.pos] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					l.pos++
+					return nil
+				}
+			case '\\':
+				l.pos++
+			case '\'':
+				end := strings.IndexByte(l.src[l.pos+1:], '\'')
+				if end < 0 {
+					return ErrIncomplete
+				}
+				l.pos += end + 1
+			case '"':
+				l.pos++
+				if err := l.scanDouble(); err != nil {
+					return err
+				}
+				continue
+			}
+			l.pos++
+		}
+		return ErrIncomplete
+	case '(':
+		return l.scanParens()
+	}
+	return nil
+}
+
+// scanParens skips a balanced (...) group starting at l.pos.
+func (l *lexer) scanParens() error {
+	depth := 0
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				l.pos++
+				return nil
+			}
+		case '\\':
+			l.pos++
+		case '\n':
+			l.line++
+		case '\'':
+			end := strings.IndexByte(l.src[l.pos+1:], '\'')
+			if end < 0 {
+				return ErrIncomplete
+			}
+			l.pos += end + 1
+		case '"':
+			l.pos++
+			if err := l.scanDouble(); err != nil {
+				return err
+			}
+			continue
+		case '`':
+			l.pos++
+			if err := l.scanBacktick(); err != nil {
+				return err
+			}
+			continue
+		}
+		l.pos++
+	}
+	return ErrIncomplete
+}
+
+// readHeredocs consumes the bodies of pending here-documents.
+func (l *lexer) readHeredocs() error {
+	for len(l.pending) > 0 {
+		r := l.pending[0]
+		var body strings.Builder
+		for {
+			if l.pos >= len(l.src) {
+				return ErrIncomplete
+			}
+			end := strings.IndexByte(l.src[l.pos:], '\n')
+			var line string
+			if end < 0 {
+				line = l.src[l.pos:]
+				l.pos = len(l.src)
+			} else {
+				line = l.src[l.pos : l.pos+end]
+				l.pos += end + 1
+			}
+			l.line++
+			check := strings.TrimSuffix(line, "\r")
+			if r.Op == "<<-" {
+				check = strings.TrimLeft(check, "\t")
+				line = strings.TrimLeft(line, "\t")
+			}
+			if check == r.Word {
+				break
+			}
+			body.WriteString(line)
+			body.WriteByte('\n')
+		}
+		r.Body = body.String()
+		l.pending = l.pending[1:]
+	}
+	return nil
+}
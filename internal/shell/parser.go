@@ -0,0 +1,631 @@
+package shell
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse parses shell source into a command list. It returns
+// ErrIncomplete if src ends inside an unfinished construct.
+func Parse(src string) (*List, error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	list, err := p.list(func(t token) bool { return t.kind == tokEOF })
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.unexpected()
+	}
+	return list, nil
+}
+
+type parser struct {
+	lex     *lexer
+	tok     token
+	prevEnd int // end offset of the token before tok
+}
+
+func (p *parser) advance() error {
+	p.prevEnd = p.tok.end
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = t
+	return nil
+}
+
+func (p *parser) unexpected() error {
+	switch p.tok.kind {
+	case tokEOF:
+		return ErrIncomplete
+	case tokNewline:
+		return fmt.Errorf("line %d: syntax error near unexpected newline", p.tok.line)
+	}
+	return fmt.Errorf("line %d: syntax error near unexpected token '%s'", p.tok.line, p.tok.text)
+}
+
+// isWord reports whether the current token is the unquoted word w.
+func (p *parser) isWord(w string) bool {
+	return p.tok.kind == tokWord && p.tok.text == w
+}
+
+func (p *parser) isOp(op string) bool {
+	return p.tok.kind == tokOp && p.tok.text == op
+}
+
+func (p *parser) expectWord(w string) error {
+	if !p.isWord(w) {
+		return p.unexpected()
+	}
+	return p.advance()
+}
+
+func (p *parser) skipNewlines() error {
+	for p.tok.kind == tokNewline {
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reserved words that terminate a list inside compound commands.
+var terminators = map[string]bool{
+	"then": true, "elif": true, "else": true, "fi": true,
+	"do": true, "done": true, "esac": true, "}": true,
+}
+
+// list parses and-or lists until stop reports true for the current token.
+func (p *parser) list(stop func(token) bool) (*List, error) {
+	l := &List{}
+	for {
+		if err := p.skipNewlines(); err != nil {
+			return nil, err
+		}
+		if stop(p.tok) || p.tok.kind == tokEOF {
+			return l, nil
+		}
+		if p.tok.kind == tokOp && (p.tok.text == ")" || p.tok.text == ";;") {
+			return l, nil
+		}
+		if p.tok.kind == tokWord && terminators[p.tok.text] {
+			return l, nil
+		}
+
+		ao, err := p.andOr()
+		if err != nil {
+			return nil, err
+		}
+		l.Items = append(l.Items, ao)
+
+		switch {
+		case p.isOp(";"):
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		case p.isOp("&"):
+			ao.Background = true
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		case p.tok.kind == tokNewline, p.tok.kind == tokEOF:
+		default:
+			if stop(p.tok) || p.isOp(")") || p.isOp(";;") ||
+				(p.tok.kind == tokWord && terminators[p.tok.text]) {
+				return l, nil
+			}
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *parser) andOr() (*AndOr, error) {
+	ao := &AndOr{}
+	start := p.tok.pos
+	for {
+		pl, err := p.pipeline()
+		if err != nil {
+			return nil, err
+		}
+		ao.Pipes = append(ao.Pipes, pl)
+		if !p.isOp("&&") && !p.isOp("||") {
+			if start < p.prevEnd {
+				ao.Text = p.lex.src[start:p.prevEnd]
+			}
+			return ao, nil
+		}
+		ao.Ops = append(ao.Ops, p.tok.text)
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.skipNewlines(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) pipeline() (*Pipeline, error) {
+	pl := &Pipeline{}
+	if p.isWord("!") {
+		pl.Negate = true
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		cmd, err := p.command()
+		if err != nil {
+			return nil, err
+		}
+		pl.Cmds = append(pl.Cmds, cmd)
+		if !p.isOp("|") {
+			return pl, nil
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.skipNewlines(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) command() (Command, error) {
+	if p.tok.kind == tokEOF {
+		return nil, ErrIncomplete
+	}
+
+	var cmd Command
+	var err error
+	switch {
+	case p.isWord("{"):
+		cmd, err = p.braceGroup()
+	case p.isOp("("):
+		cmd, err = p.subshell()
+	case p.isWord("if"):
+		cmd, err = p.ifCmd()
+	case p.isWord("for"):
+		cmd, err = p.forCmd()
+	case p.isWord("while"), p.isWord("until"):
+		cmd, err = p.whileCmd()
+	case p.isWord("case"):
+		cmd, err = p.caseCmd()
+	case p.isWord("function"):
+		return p.funcDef(true)
+	case p.tok.kind == tokWord && isName(p.tok.text):
+		// NAME ( ) starts a function definition.
+		name := p.tok.text
+		save := *p.lex
+		saveTok := p.tok
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.isOp("(") {
+			return p.funcBody(name)
+		}
+		*p.lex = save
+		p.tok = saveTok
+		return p.simpleCommand()
+	default:
+		return p.simpleCommand()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var redirs []*Redir
+	for p.isRedirOp() {
+		r, err := p.redirect()
+		if err != nil {
+			return nil, err
+		}
+		redirs = append(redirs, r)
+	}
+	if len(redirs) > 0 {
+		return &Redirected{Cmd: cmd, Redirs: redirs}, nil
+	}
+	return cmd, nil
+}
+
+func (p *parser) funcDef(keyword bool) (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokWord || !isName(p.tok.text) {
+		return nil, p.unexpected()
+	}
+	name := p.tok.text
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.isOp("(") {
+		return p.funcBody(name)
+	}
+	if err := p.skipNewlines(); err != nil {
+		return nil, err
+	}
+	body, err := p.command()
+	if err != nil {
+		return nil, err
+	}
+	return &FuncDef{Name: name, Body: body}, nil
+}
+
+// funcBody parses "( ) body" after a function name.
+func (p *parser) funcBody(name string) (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if !p.isOp(")") {
+		return nil, p.unexpected()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if err := p.skipNewlines(); err != nil {
+		return nil, err
+	}
+	body, err := p.command()
+	if err != nil {
+		return nil, err
+	}
+	return &FuncDef{Name: name, Body: body}, nil
+}
+
+func (p *parser) braceGroup() (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	body, err := p.list(func(t token) bool { return t.kind == tokWord && t.text == "}" })
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectWord("}"); err != nil {
+		return nil, err
+	}
+	return &BraceGroup{Body: body}, nil
+}
+
+func (p *parser) subshell() (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	body, err := p.list(func(t token) bool { return t.kind == tokOp && t.text == ")" })
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp(")") {
+		return nil, p.unexpected()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	return &Subshell{Body: body}, nil
+}
+
+func wordStop(words ...string) func(token) bool {
+	return func(t token) bool {
+		if t.kind != tokWord {
+			return false
+		}
+		for _, w := range words {
+			if t.text == w {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (p *parser) ifCmd() (Command, error) {
+	c := &IfCmd{}
+	for {
+		// At "if" or "elif".
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		cond, err := p.list(wordStop("then"))
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectWord("then"); err != nil {
+			return nil, err
+		}
+		body, err := p.list(wordStop("elif", "else", "fi"))
+		if err != nil {
+			return nil, err
+		}
+		c.Conds = append(c.Conds, cond)
+		c.Bodies = append(c.Bodies, body)
+
+		if p.isWord("elif") {
+			continue
+		}
+		if p.isWord("else") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			c.Else, err = p.list(wordStop("fi"))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expectWord("fi"); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+}
+
+func (p *parser) forCmd() (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokWord || !isName(p.tok.text) {
+		return nil, p.unexpected()
+	}
+	c := &ForCmd{Var: p.tok.text}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if err := p.skipNewlines(); err != nil {
+		return nil, err
+	}
+	if p.isWord("in") {
+		c.HasIn = true
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		for p.tok.kind == tokWord {
+			c.Items = append(c.Items, p.tok.text)
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if p.isOp(";") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.skipNewlines(); err != nil {
+		return nil, err
+	}
+	body, err := p.doGroup()
+	if err != nil {
+		return nil, err
+	}
+	c.Body = body
+	return c, nil
+}
+
+func (p *parser) doGroup() (*List, error) {
+	if err := p.expectWord("do"); err != nil {
+		return nil, err
+	}
+	body, err := p.list(wordStop("done"))
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectWord("done"); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (p *parser) whileCmd() (Command, error) {
+	c := &WhileCmd{Until: p.tok.text == "until"}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	cond, err := p.list(wordStop("do"))
+	if err != nil {
+		return nil, err
+	}
+	c.Cond = cond
+	c.Body, err = p.doGroup()
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (p *parser) caseCmd() (Command, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokWord {
+		return nil, p.unexpected()
+	}
+	c := &CaseCmd{Word: p.tok.text}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if err := p.skipNewlines(); err != nil {
+		return nil, err
+	}
+	if err := p.expectWord("in"); err != nil {
+		return nil, err
+	}
+	for {
+		if err := p.skipNewlines(); err != nil {
+			return nil, err
+		}
+		if p.isWord("esac") {
+			return c, p.advance()
+		}
+		if p.tok.kind == tokEOF {
+			return nil, ErrIncomplete
+		}
+		if p.isOp("(") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+
+		var item CaseItem
+		for {
+			if p.tok.kind != tokWord {
+				return nil, p.unexpected()
+			}
+			item.Patterns = append(item.Patterns, p.tok.text)
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.isOp("|") {
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if !p.isOp(")") {
+				return nil, p.unexpected()
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		body, err := p.list(wordStop("esac"))
+		if err != nil {
+			return nil, err
+		}
+		item.Body = body
+		c.Items = append(c.Items, item)
+
+		if p.isOp(";;") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := p.skipNewlines(); err != nil {
+			return nil, err
+		}
+		if !p.isWord("esac") {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *parser) isRedirOp() bool {
+	if p.tok.kind != tokOp {
+		return false
+	}
+	switch p.tok.text {
+	case "<", ">", ">>", ">|", "<>", "<&", ">&", "&>", "<<", "<<-":
+		return true
+	}
+	return false
+}
+
+func (p *parser) redirect() (*Redir, error) {
+	r := &Redir{Op: p.tok.text, Fd: p.tok.fd}
+	if r.Fd < 0 {
+		r.Fd = 1
+		if strings.HasPrefix(r.Op, "<") {
+			r.Fd = 0
+		}
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokWord {
+		return nil, p.unexpected()
+	}
+	r.Word = p.tok.text
+	if r.Op == "<<" || r.Op == "<<-" {
+		if strings.ContainsAny(r.Word, `'"\`) {
+			r.Quoted = true
+			r.Word = removeQuotes(r.Word)
+		}
+		p.lex.pending = append(p.lex.pending, r)
+	}
+	return r, p.advance()
+}
+
+func (p *parser) simpleCommand() (Command, error) {
+	c := &SimpleCmd{Line: p.tok.line}
+	for {
+		switch {
+		case p.isRedirOp():
+			r, err := p.redirect()
+			if err != nil {
+				return nil, err
+			}
+			c.Redirs = append(c.Redirs, r)
+		case p.tok.kind == tokWord:
+			if len(c.Words) == 0 {
+				if name, value, ok := splitAssign(p.tok.text); ok {
+					c.Assigns = append(c.Assigns, Assign{Name: name, Value: value})
+					if err := p.advance(); err != nil {
+						return nil, err
+					}
+					continue
+				}
+			}
+			c.Words = append(c.Words, p.tok.text)
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		default:
+			if len(c.Words) == 0 && len(c.Assigns) == 0 && len(c.Redirs) == 0 {
+				return nil, p.unexpected()
+			}
+			return c, nil
+		}
+	}
+}
+
+// splitAssign splits NAME=value words.
+func splitAssign(word string) (name, value string, ok bool) {
+	i := strings.IndexByte(word, '=')
+	if i <= 0 || !isName(word[:i]) {
+		return "", "", false
+	}
+	return word[:i], word[i+1:], true
+}
+
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// removeQuotes strips quoting from a word without expanding it.
+func removeQuotes(word string) string {
+	var b strings.Builder
+	for i := 0; i < len(word); i++ {
+		switch c := word[i]; c {
+		case '\'', '"':
+			end := strings.IndexByte(word[i+1:], c)
+			if end < 0 {
+				b.WriteString(word[i+1:])
+				return b.String()
+			}
+			b.WriteString(word[i+1 : i+1+end])
+			i += end + 1
+		case '\\':
+			if i+1 < len(word) {
+				i++
+				b.WriteByte(word[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
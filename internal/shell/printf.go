@@ -0,0 +1,179 @@
+package shell
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// builtinPrintf implements printf FORMAT [ARG]... The format is reused
+// until all arguments are consumed.
+func builtinPrintf(sh *Shell, st stdio, argv []string) int {
+	if len(argv) < 2 {
+		fmt.Fprintln(st.err, "sh: printf: usage: printf format [arguments]")
+		return utils.ExitUsageError
+	}
+	format, args := argv[1], argv[2:]
+	status := utils.ExitSuccess
+
+	var out strings.Builder
+	for {
+		consumed := 0
+		stop := false
+		for i := 0; i < len(format) && !stop; i++ {
+			c := format[i]
+			if c == '\\' {
+				n, s := unescape(format[i:])
+				out.WriteString(s)
+				i += n - 1
+				continue
+			}
+			if c != '%' {
+				out.WriteByte(c)
+				continue
+			}
+			if i+1 < len(format) && format[i+1] == '%' {
+				out.WriteByte('%')
+				i++
+				continue
+			}
+
+			// %[flags][width][.precision]verb
+			j := i + 1
+			for j < len(format) && strings.IndexByte("-+ #0", format[j]) >= 0 {
+				j++
+			}
+			for j < len(format) && (format[j] >= '0' && format[j] <= '9' || format[j] == '.') {
+				j++
+			}
+			if j >= len(format) {
+				out.WriteString(format[i:])
+				break
+			}
+			spec, verb := format[i:j], format[j]
+			i = j
+
+			arg := ""
+			if consumed < len(args) {
+				arg = args[consumed]
+			}
+			consumed++
+
+			switch verb {
+			case 's':
+				out.WriteString(fmt.Sprintf(spec+"s", arg))
+			case 'b':
+				s, cut := expandBackslashes(arg)
+				out.WriteString(fmt.Sprintf(spec+"s", s))
+				stop = cut
+			case 'c':
+				if arg != "" {
+					out.WriteString(fmt.Sprintf(spec+"c", []rune(arg)[0]))
+				}
+			case 'd', 'i', 'x', 'X', 'o', 'u':
+				n, err := parseNumArg(arg)
+				if err != nil {
+					fmt.Fprintf(st.err, "sh: printf: %s: invalid number\n", arg)
+					status = utils.ExitFailure
+				}
+				v := verb
+				if v == 'i' || v == 'u' {
+					v = 'd'
+				}
+				out.WriteString(fmt.Sprintf(spec+string(v), n))
+			case 'f', 'e', 'E', 'g', 'G':
+				f, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+				if err != nil && arg != "" {
+					fmt.Fprintf(st.err, "sh: printf: %s: invalid number\n", arg)
+					status = utils.ExitFailure
+				}
+				out.WriteString(fmt.Sprintf(spec+string(verb), f))
+			default:
+				fmt.Fprintf(st.err, "sh: printf: %%%c: invalid directive\n", verb)
+				return utils.ExitFailure
+			}
+		}
+		if stop || consumed == 0 || consumed >= len(args) {
+			break
+		}
+		args = args[consumed:]
+	}
+
+	fmt.Fprint(st.out, out.String())
+	return status
+}
+
+// parseNumArg parses a printf numeric argument; 'c yields a character code.
+func parseNumArg(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if s[0] == '\'' || s[0] == '"' {
+		if len(s) > 1 {
+			return int64([]rune(s[1:])[0]), nil
+		}
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 0, 64)
+}
+
+// unescape decodes the backslash escape at the start of s and returns
+// its length and value.
+func unescape(s string) (int, string) {
+	if len(s) < 2 {
+		return 1, s
+	}
+	switch s[1] {
+	case 'n':
+		return 2, "\n"
+	case 't':
+		return 2, "\t"
+	case 'r':
+		return 2, "\r"
+	case 'a':
+		return 2, "\a"
+	case 'b':
+		return 2, "\b"
+	case 'f':
+		return 2, "\f"
+	case 'v':
+		return 2, "\v"
+	case 'e':
+		return 2, "\x1b"
+	case '\\':
+		return 2, "\\"
+	case '"':
+		return 2, "\""
+	case '\'':
+		return 2, "'"
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		n, v := 1, 0
+		for n < len(s) && n < 4 && s[n] >= '0' && s[n] <= '7' {
+			v = v*8 + int(s[n]-'0')
+			n++
+		}
+		return n, string([]byte{byte(v)})
+	}
+	return 2, s[:2]
+}
+
+// expandBackslashes handles %b arguments; \c stops all further output.
+func expandBackslashes(s string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == 'c' {
+			return b.String(), true
+		}
+		n, v := unescape(s[i:])
+		b.WriteString(v)
+		i += n - 1
+	}
+	return b.String(), false
+}
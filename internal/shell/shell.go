@@ -0,0 +1,452 @@
+// Package shell implements a small POSIX-style shell on top of the
+// winux command registry. It runs winux commands in-process and other
+// programs through the operating system, so the same .sh scripts work
+// on Windows without WSL.
+package shell
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// variable is a shell variable.
+type variable struct {
+	value    string
+	exported bool
+}
+
+type options struct {
+	errexit  bool // -e
+	xtrace   bool // -x
+	nounset  bool // -u
+	noglob   bool // -f
+	pipefail bool // -o pipefail
+}
+
+// Shell holds interpreter state: variables, functions, aliases,
+// positional parameters, working directory and history.
+type Shell struct {
+	ctx *core.Context
+
+	dir     string
+	vars    map[string]*variable
+	funcs   map[string]Command
+	aliases map[string]string
+
+	name   string   // $0
+	args   []string // positional parameters
+	status int      // $?
+	opts   options
+
+	interactive bool
+	history     []string
+	jobs        *jobTable
+
+	// locals holds the saved values of variables declared with local,
+	// one frame per active function call.
+	locals []map[string]*variable
+
+	// Control flow requested by break, continue, return and exit.
+	breakN, continueN int
+	returning         bool
+	exiting           bool
+
+	substituted bool // a command substitution ran in the current command
+	condDepth   int  // nesting of if/while conditions
+	arithDepth  int
+}
+
+// stdio are the streams a command runs with.
+type stdio struct {
+	in       io.Reader
+	out, err io.Writer
+}
+
+// fatalError aborts a non-interactive shell, as for ${x:?}.
+type fatalError struct{ msg string }
+
+func (e *fatalError) Error() string { return e.msg }
+
+// New returns a shell using the streams, directory and environment of ctx.
+func New(ctx *core.Context) *Shell {
+	if ctx.Context == nil {
+		ctx = ctx.WithContext(context.Background())
+	}
+	sh := &Shell{
+		ctx:     ctx,
+		dir:     ctx.Dir,
+		vars:    make(map[string]*variable),
+		funcs:   make(map[string]Command),
+		aliases: make(map[string]string),
+		name:    "sh",
+		jobs:    &jobTable{},
+	}
+	if sh.dir == "" {
+		sh.dir, _ = os.Getwd()
+	}
+
+	for _, kv := range ctx.Env {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" {
+			continue
+		}
+		// Windows spells it "Path"; scripts expect $PATH.
+		if runtime.GOOS == "windows" && strings.EqualFold(k, "PATH") {
+			k = "PATH"
+		}
+		sh.vars[k] = &variable{value: v, exported: true}
+	}
+	if _, ok := sh.vars["HOME"]; !ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			sh.vars["HOME"] = &variable{value: home, exported: true}
+		}
+	}
+	sh.vars["PWD"] = &variable{value: sh.dir, exported: true}
+	sh.vars["IFS"] = &variable{value: " \t\n"}
+	if _, ok := sh.vars["PS1"]; !ok {
+		sh.vars["PS1"] = &variable{value: `\w\$ `}
+	}
+	if _, ok := sh.vars["PS2"]; !ok {
+		sh.vars["PS2"] = &variable{value: "> "}
+	}
+	return sh
+}
+
+// SetArgs sets $0 and the positional parameters.
+func (sh *Shell) SetArgs(name string, args []string) {
+	sh.name = name
+	sh.args = append([]string(nil), args...)
+}
+
+// SetOption sets a single-letter option such as "e" or "x", or the
+// long option "pipefail".
+func (sh *Shell) SetOption(opt string, on bool) bool {
+	switch opt {
+	case "e", "errexit":
+		sh.opts.errexit = on
+	case "x", "xtrace":
+		sh.opts.xtrace = on
+	case "u", "nounset":
+		sh.opts.nounset = on
+	case "f", "noglob":
+		sh.opts.noglob = on
+	case "pipefail":
+		sh.opts.pipefail = on
+	default:
+		return false
+	}
+	return true
+}
+
+// Run parses and executes src, returning the exit status.
+func (sh *Shell) Run(src string) int {
+	return sh.runSource(src, sh.stdio())
+}
+
+// RunFile executes the script at path.
+func (sh *Shell) RunFile(path string) int {
+	data, err := vfs.ReadFile(sh.ctx.Files(), sh.path(path))
+	if err != nil {
+		fmt.Fprintf(sh.ctx.Stderr, "sh: %s: %v\n", path, errText(err))
+		return utils.ExitCommandNotFound
+	}
+	return sh.Run(string(data))
+}
+
+func (sh *Shell) stdio() stdio {
+	return stdio{in: sh.ctx.Stdin, out: sh.ctx.Stdout, err: sh.ctx.Stderr}
+}
+
+// runSource parses and runs src with the given streams.
+func (sh *Shell) runSource(src string, st stdio) int {
+	src = strings.TrimPrefix(src, "\ufeff")
+	list, err := Parse(src)
+	if err != nil {
+		if err == ErrIncomplete {
+			err = errors.New("syntax error: unexpected end of file")
+		}
+		fmt.Fprintf(st.err, "sh: %v\n", err)
+		sh.status = utils.ExitUsageError
+		if !sh.interactive {
+			sh.exiting = true
+		}
+		return sh.status
+	}
+	return sh.runList(list, st)
+}
+
+// Interactive runs a read-eval-print loop on the shell's streams.
+func (sh *Shell) Interactive() int {
+	sh.interactive = true
+	sh.loadHistory()
+	in := bufio.NewReader(sh.ctx.Stdin)
+	st := sh.stdio()
+
+	var buf strings.Builder
+	for !sh.exiting {
+		prompt := "PS1"
+		if buf.Len() > 0 {
+			prompt = "PS2"
+		}
+		fmt.Fprint(sh.ctx.Stderr, sh.prompt(prompt))
+
+		line, err := in.ReadString('\n')
+		if line == "" && err != nil {
+			if buf.Len() == 0 {
+				fmt.Fprintln(sh.ctx.Stderr)
+				break
+			}
+			fmt.Fprintln(sh.ctx.Stderr, "sh: syntax error: unexpected end of file")
+			break
+		}
+		line = strings.TrimRight(line, "\r\n")
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+
+		src := buf.String()
+		list, perr := Parse(src)
+		if perr == ErrIncomplete {
+			continue
+		}
+		buf.Reset()
+		if strings.TrimSpace(src) != "" {
+			sh.addHistory(strings.TrimRight(src, "\n"))
+		}
+		if perr != nil {
+			fmt.Fprintf(sh.ctx.Stderr, "sh: %v\n", perr)
+			sh.status = utils.ExitUsageError
+			continue
+		}
+
+		sh.runInterruptible(list, st)
+		sh.breakN, sh.continueN, sh.returning = 0, 0, false
+	}
+	return sh.status
+}
+
+// prompt expands PS1 or PS2 escapes: \w, \W, \u, \h, \$ and \n.
+func (sh *Shell) prompt(name string) string {
+	ps, _ := sh.lookup(name)
+	var b strings.Builder
+	for i := 0; i < len(ps); i++ {
+		if ps[i] != '\\' || i+1 >= len(ps) {
+			b.WriteByte(ps[i])
+			continue
+		}
+		i++
+		switch ps[i] {
+		case 'w':
+			b.WriteString(sh.tildeDir())
+		case 'W':
+			b.WriteString(filepath.Base(sh.dir))
+		case 'u':
+			b.WriteString(firstNonEmpty(sh.getenv("USER"), sh.getenv("USERNAME")))
+		case 'h':
+			host, _ := os.Hostname()
+			b.WriteString(strings.SplitN(host, ".", 2)[0])
+		case '$':
+			b.WriteByte('$')
+		case 'n':
+			b.WriteByte('\n')
+		case '\\':
+			b.WriteByte('\\')
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(ps[i])
+		}
+	}
+	return b.String()
+}
+
+// tildeDir abbreviates the home directory prefix of the working directory.
+func (sh *Shell) tildeDir() string {
+	home, _ := sh.lookup("HOME")
+	if home != "" && (sh.dir == home || strings.HasPrefix(sh.dir, home+string(filepath.Separator))) {
+		return "~" + filepath.ToSlash(sh.dir[len(home):])
+	}
+	return sh.dir
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// subshell returns a copy of sh whose changes do not affect sh.
+func (sh *Shell) subshell() *Shell {
+	sub := *sh
+	sub.vars = make(map[string]*variable, len(sh.vars))
+	for k, v := range sh.vars {
+		c := *v
+		sub.vars[k] = &c
+	}
+	sub.funcs = make(map[string]Command, len(sh.funcs))
+	for k, v := range sh.funcs {
+		sub.funcs[k] = v
+	}
+	sub.aliases = make(map[string]string, len(sh.aliases))
+	for k, v := range sh.aliases {
+		sub.aliases[k] = v
+	}
+	sub.args = append([]string(nil), sh.args...)
+	sub.locals = nil
+	sub.interactive = false
+	sub.history = nil
+	return &sub
+}
+
+// lookup returns the value of a variable.
+func (sh *Shell) lookup(name string) (string, bool) {
+	if v, ok := sh.vars[name]; ok {
+		return v.value, true
+	}
+	return "", false
+}
+
+func (sh *Shell) getenv(name string) string {
+	v, _ := sh.lookup(name)
+	return v
+}
+
+// lookupParam returns a special, positional or named parameter.
+func (sh *Shell) lookupParam(name string) (string, bool) {
+	switch name {
+	case "?":
+		return strconv.Itoa(sh.status), true
+	case "#":
+		return strconv.Itoa(len(sh.args)), true
+	case "$":
+		return strconv.Itoa(os.Getpid()), true
+	case "!":
+		if pid := sh.jobs.lastPid(); pid > 0 {
+			return strconv.Itoa(pid), true
+		}
+		return "", false
+	case "-":
+		return sh.flags(), true
+	case "0":
+		return sh.name, true
+	case "@", "*":
+		return strings.Join(sh.args, sh.ifsJoin()), len(sh.args) > 0
+	}
+	if isDigits(name) {
+		n, _ := strconv.Atoi(name)
+		if n >= 1 && n <= len(sh.args) {
+			return sh.args[n-1], true
+		}
+		return "", false
+	}
+	return sh.lookup(name)
+}
+
+// param is lookupParam with the nounset check.
+func (sh *Shell) param(name string) (string, error) {
+	v, ok := sh.lookupParam(name)
+	if !ok && sh.opts.nounset && name != "@" && name != "*" && name != "!" {
+		return "", &fatalError{name + ": unbound variable"}
+	}
+	return v, nil
+}
+
+func (sh *Shell) ifsJoin() string {
+	ifs, ok := sh.lookup("IFS")
+	if !ok {
+		return " "
+	}
+	if ifs == "" {
+		return ""
+	}
+	return ifs[:1]
+}
+
+func (sh *Shell) flags() string {
+	var b strings.Builder
+	if sh.opts.errexit {
+		b.WriteByte('e')
+	}
+	if sh.opts.noglob {
+		b.WriteByte('f')
+	}
+	if sh.interactive {
+		b.WriteByte('i')
+	}
+	if sh.opts.nounset {
+		b.WriteByte('u')
+	}
+	if sh.opts.xtrace {
+		b.WriteByte('x')
+	}
+	return b.String()
+}
+
+// setVar assigns a variable, keeping its export flag.
+func (sh *Shell) setVar(name, value string) {
+	if v, ok := sh.vars[name]; ok {
+		v.value = value
+	} else {
+		sh.vars[name] = &variable{value: value}
+	}
+	if name == "PWD" && value != "" {
+		sh.dir = value
+	}
+}
+
+// environ builds the environment for child commands.
+func (sh *Shell) environ(extra []string) []string {
+	names := make([]string, 0, len(sh.vars))
+	for k, v := range sh.vars {
+		if v.exported {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	env := make([]string, 0, len(names)+len(extra))
+	for _, k := range names {
+		env = append(env, k+"="+sh.vars[k].value)
+	}
+	return append(env, extra...)
+}
+
+// path resolves name against the shell's working directory.
+func (sh *Shell) path(name string) string {
+	if name == "/dev/null" {
+		return os.DevNull
+	}
+	if filepath.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return name
+	}
+	return filepath.Join(sh.dir, name)
+}
+
+// errText strips the operation and path from file errors, since
+// diagnostics already name the file.
+func errText(err error) string {
+	var pe *os.PathError
+	if errors.As(err, &pe) {
+		err = pe.Err
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return "No such file or directory"
+	}
+	if errors.Is(err, os.ErrPermission) {
+		return "Permission denied"
+	}
+	return err.Error()
+}
@@ -0,0 +1,290 @@
+package shell
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CRTYPUBG/winux/internal/core"
+	"github.com/CRTYPUBG/winux/internal/utils"
+	"github.com/CRTYPUBG/winux/internal/vfs"
+)
+
+// The shell runs registered commands in-process; these stand in for the
+// real echo and cat, which live in a package that imports this one.
+func init() {
+	core.Register("echo", func(ctx *core.Context, args []string) int {
+		fmt.Fprintln(ctx.Stdout, strings.Join(args, " "))
+		return utils.ExitSuccess
+	})
+	core.Register("cat", func(ctx *core.Context, args []string) int {
+		if len(args) == 0 {
+			io.Copy(ctx.Stdout, ctx.Stdin)
+			return utils.ExitSuccess
+		}
+		for _, name := range args {
+			data, err := vfs.ReadFile(ctx.Files(), ctx.Dir+"/"+name)
+			if err != nil {
+				fmt.Fprintf(ctx.Stderr, "cat: %s: %v\n", name, err)
+				return utils.ExitFailure
+			}
+			ctx.Stdout.Write(data)
+		}
+		return utils.ExitSuccess
+	})
+}
+
+// newTestShell returns a shell in /work on an in-memory file system
+// holding files, with no PATH so nothing outside the registry runs.
+func newTestShell(t *testing.T, stdin string, files map[string]string) (*Shell, *bytes.Buffer, *bytes.Buffer, *vfs.Mem) {
+	t.Helper()
+	fsys := vfs.NewMem(time.Now)
+	if err := fsys.MkdirAll("/work", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		if err := fsys.AddFile("/work/"+name, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var stdout, stderr bytes.Buffer
+	ctx := core.NewContext()
+	ctx.Stdin = strings.NewReader(stdin)
+	ctx.Stdout, ctx.Stderr = &stdout, &stderr
+	ctx.Dir = "/work"
+	ctx.Env = []string{"HOME=/home/winux", "PATH="}
+	ctx.FS = fsys
+	return New(ctx), &stdout, &stderr, fsys
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		src     string
+		items   int
+		wantErr string // substring of the error; "" for none
+	}{
+		{"echo a; echo b", 2, ""},
+		{"echo a\necho b\n", 2, ""},
+		{"a && b || c", 1, ""},
+		{"a | b | c &", 1, ""},
+		{"# only a comment", 0, ""},
+		{"if true; then echo y; fi", 1, ""},
+		{"for i in 1 2; do echo $i; done", 1, ""},
+		{"f() { echo f; }; f", 2, ""},
+		{"case x in x) echo x;; esac", 1, ""},
+		{"cat <<EOF\nbody\nEOF\n", 1, ""},
+		{"echo 'open", 0, ErrIncomplete.Error()},
+		{"echo \"open", 0, ErrIncomplete.Error()},
+		{"if true; then", 0, ErrIncomplete.Error()},
+		{"while true; do", 0, ErrIncomplete.Error()},
+		{"echo a &&", 0, ErrIncomplete.Error()},
+		{"cat <<EOF\nno end", 0, ErrIncomplete.Error()},
+		{"echo )", 0, "line 1: syntax error near unexpected token ')'"},
+		{"echo a\n;;", 0, "line 2: syntax error"},
+		{"if true; fi", 0, "syntax error near unexpected token 'fi'"},
+	}
+	for _, tt := range tests {
+		list, err := Parse(tt.src)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse(%q) error = %v, want %q", tt.src, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.src, err)
+			continue
+		}
+		if len(list.Items) != tt.items {
+			t.Errorf("Parse(%q) has %d items, want %d", tt.src, len(list.Items), tt.items)
+		}
+	}
+}
+
+func TestParseText(t *testing.T) {
+	list, err := Parse("sleep 1 &&  echo 'a b' | cat &\n  echo c # note\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"sleep 1 &&  echo 'a b' | cat", "echo c"}
+	if len(list.Items) != len(want) {
+		t.Fatalf("got %d items, want %d", len(list.Items), len(want))
+	}
+	for i, ao := range list.Items {
+		if ao.Text != want[i] {
+			t.Errorf("item %d text = %q, want %q", i, ao.Text, want[i])
+		}
+	}
+	if !list.Items[0].Background || list.Items[1].Background {
+		t.Errorf("background flags = %v, %v", list.Items[0].Background, list.Items[1].Background)
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		stdout string
+		status int
+	}{
+		// Quoting.
+		{"single quotes", `echo 'a  $x  "b"'`, "a  $x  \"b\"\n", 0},
+		{"double quotes", `x=1; echo "x=$x  \$x \"q\""`, "x=1  $x \"q\"\n", 0},
+		{"backslash", `echo a\ b\$c`, "a b$c\n", 0},
+		{"empty quotes", `printf '[%s]' '' ""; echo`, "[][]\n", 0},
+		{"adjacent quotes", `echo 'a'"b"c`, "abc\n", 0},
+
+		// Expansion.
+		{"field splitting", `x='a   b'; printf '[%s]' $x "$x"; echo`, "[a][b][a   b]\n", 0},
+		{"default", `echo ${unset:-def} ${unset-def2}`, "def def2\n", 0},
+		{"assign default", `echo ${y:=set}; echo $y`, "set\nset\n", 0},
+		{"alternate", `x=1; echo ${x:+alt}`, "alt\n", 0},
+		{"length", `x=hello; echo ${#x}`, "5\n", 0},
+		{"strip", `f=dir/name.txt; echo ${f##*/} ${f%.txt}`, "name.txt dir/name\n", 0},
+		{"command substitution", `echo "[$(echo a; echo b)]"`, "[a\nb]\n", 0},
+		{"backticks", "echo `echo x`", "x\n", 0},
+		{"arithmetic", `x=4; echo $((x * 2 + 1)) $((7 / 2)) $((7 % 3))`, "9 3 1\n", 0},
+		{"positional", `set -- a b c; echo $# $2 "$*"`, "3 b a b c\n", 0},
+		{"status", `false; echo $?; true; echo $?`, "1\n0\n", 0},
+		{"tilde", `echo ~ ~/x`, "/home/winux /home/winux/x\n", 0},
+		{"glob", `echo *.txt`, "a.txt b.txt\n", 0},
+		{"glob no match", `echo *.none`, "*.none\n", 0},
+		{"glob quoted", `echo "*.txt"`, "*.txt\n", 0},
+
+		// Control flow.
+		{"if", `if true; then echo y; else echo n; fi`, "y\n", 0},
+		{"elif", `if false; then echo 1; elif true; then echo 2; else echo 3; fi`, "2\n", 0},
+		{"if status", `if false; then :; fi; echo $?`, "0\n", 0},
+		{"for", `for i in a b c; do echo $i; done`, "a\nb\nc\n", 0},
+		{"for positional", `set -- x y; for i; do echo $i; done`, "x\ny\n", 0},
+		{"while", `i=0; while [ $i -lt 3 ]; do echo $i; i=$((i+1)); done`, "0\n1\n2\n", 0},
+		{"until", `i=0; until [ $i -eq 2 ]; do i=$((i+1)); done; echo $i`, "2\n", 0},
+		{"break continue", `for i in 1 2 3 4; do [ $i = 2 ] && continue; [ $i = 4 ] && break; echo $i; done`, "1\n3\n", 0},
+		{"case", `case foo.go in *.txt) echo t;; *.go) echo g;; esac`, "g\n", 0},
+		{"and or", `false && echo no || echo yes`, "yes\n", 0},
+		{"function", `f() { echo "f:$1"; return 3; }; f arg; echo $?`, "f:arg\n3\n", 0},
+		{"subshell", `x=1; (x=2; echo $x); echo $x`, "2\n1\n", 0},
+		{"exit", `echo a; exit 4; echo b`, "a\n", 4},
+
+		// Pipelines.
+		{"pipeline", `echo hello | cat | cat`, "hello\n", 0},
+		{"pipeline status", `true | false`, "", 1},
+		{"negate", `! false; echo $?`, "0\n", 0},
+		{"pipefail", `set -o pipefail; false | true; echo $?`, "1\n", 0},
+		{"read from pipe", `echo 'x y' | { read a b; echo "$b$a"; }`, "yx\n", 0},
+
+		// Redirections.
+		{"output", `echo a > out; echo b >> out; cat out`, "a\nb\n", 0},
+		{"input", `cat < a.txt`, "A\n", 0},
+		{"stderr to stdout", `cat missing 2>&1 | cat > /dev/null; cat nope 2>/dev/null; echo $?`, "1\n", 0},
+		{"here-document", "x=v\ncat <<EOF\n$x\nEOF\n", "v\n", 0},
+		{"quoted here-document", "x=v\ncat <<'EOF'\n$x\nEOF\n", "$x\n", 0},
+		{"stripped here-document", "cat <<-EOF\n\tindented\n\tEOF\n", "indented\n", 0},
+		{"group redirect", `{ echo 1; echo 2; } > out; cat out`, "1\n2\n", 0},
+
+		// Errors.
+		{"not found", `nosuchcommand`, "", utils.ExitCommandNotFound},
+		{"unset error", `set -u; echo $nope; echo after`, "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sh, stdout, stderr, _ := newTestShell(t, "", map[string]string{
+				"a.txt": "A\n",
+				"b.txt": "B\n",
+			})
+			status := sh.Run(tt.src)
+			if got := stdout.String(); got != tt.stdout {
+				t.Errorf("stdout = %q, want %q (stderr %q)", got, tt.stdout, stderr.String())
+			}
+			if status != tt.status {
+				t.Errorf("status = %d, want %d (stderr %q)", status, tt.status, stderr.String())
+			}
+		})
+	}
+}
+
+func TestRedirectWritesFile(t *testing.T) {
+	sh, _, stderr, fsys := newTestShell(t, "", nil)
+	if status := sh.Run(`echo one > f; echo two 2>&1 >> f; cat missing 2> err`); status != utils.ExitFailure {
+		t.Errorf("status = %d, want 1 (stderr %q)", status, stderr.String())
+	}
+	data, err := vfs.ReadFile(fsys, "/work/f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "one\ntwo\n" {
+		t.Errorf("f = %q", data)
+	}
+	data, err = vfs.ReadFile(fsys, "/work/err")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "cat: missing:") {
+		t.Errorf("err = %q", data)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr = %q, want nothing", stderr.String())
+	}
+}
+
+func TestJobs(t *testing.T) {
+	t.Run("notice", func(t *testing.T) {
+		sh, _, stderr, _ := newTestShell(t, "", nil)
+		sh.interactive = true
+		sh.Run("true &")
+		want := fmt.Sprintf("[1] %d\n", os.Getpid()+1)
+		if stderr.String() != want {
+			t.Errorf("notice = %q, want %q", stderr.String(), want)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		// The job blocks reading stdin until the pipe is closed.
+		r, w := io.Pipe()
+		sh, stdout, _, _ := newTestShell(t, "", nil)
+		sh.ctx.Stdin = r
+		sh.Run("read line && echo \"got $line\" &\njobs")
+		w.Write([]byte("x\n"))
+		w.Close()
+		sh.Run("wait %1; echo $?; jobs")
+		want := "[1]  Running                 read line && echo \"got $line\"\n" +
+			"got x\n0\n"
+		if got := stdout.String(); got != want {
+			t.Errorf("stdout = %q, want %q", got, want)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		src    string
+		stdout string
+	}{
+		{"wait all", "false &\nexit 3 &\nwait; echo $?", "0\n"},
+		{"wait job", "exit 3 &\nwait %1; echo $?", "3\n"},
+		{"wait pid", "exit 5 &\nwait $!; echo $?", "5\n"},
+		{"wait unknown", "wait %9; echo $?", "127\n"},
+		{"pid", "true &\n[ $! -gt $$ ] && echo ok", "ok\n"},
+		{"output", "echo bg > out &\nwait; cat out", "bg\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sh, stdout, stderr, _ := newTestShell(t, "", nil)
+			sh.Run(tt.src)
+			if got := stdout.String(); got != tt.stdout {
+				t.Errorf("stdout = %q, want %q (stderr %q)", got, tt.stdout, stderr.String())
+			}
+		})
+	}
+}
+
+func TestIncomplete(t *testing.T) {
+	_, err := Parse("for i in a b; do\necho $i")
+	if !errors.Is(err, ErrIncomplete) {
+		t.Errorf("error = %v, want ErrIncomplete", err)
+	}
+}
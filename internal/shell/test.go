@@ -0,0 +1,244 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/CRTYPUBG/winux/internal/utils"
+)
+
+// builtinTest implements test and [.
+func builtinTest(sh *Shell, st stdio, argv []string) int {
+	args := argv[1:]
+	if argv[0] == "[" {
+		if len(args) == 0 || args[len(args)-1] != "]" {
+			fmt.Fprintln(st.err, "sh: [: missing ']'")
+			return utils.ExitUsageError
+		}
+		args = args[:len(args)-1]
+	}
+	t := &tester{sh: sh, args: args}
+	ok, err := t.expr()
+	if err == nil && t.pos < len(t.args) {
+		err = fmt.Errorf("%s: unexpected argument", t.args[t.pos])
+	}
+	if err != nil {
+		fmt.Fprintf(st.err, "sh: %s: %v\n", argv[0], err)
+		return utils.ExitUsageError
+	}
+	if ok {
+		return utils.ExitSuccess
+	}
+	return utils.ExitFailure
+}
+
+type tester struct {
+	sh   *Shell
+	args []string
+	pos  int
+}
+
+func (t *tester) peek() string {
+	if t.pos < len(t.args) {
+		return t.args[t.pos]
+	}
+	return ""
+}
+
+func (t *tester) expr() (bool, error) {
+	if len(t.args) == 0 {
+		return false, nil
+	}
+	x, err := t.and()
+	if err != nil {
+		return false, err
+	}
+	for t.peek() == "-o" {
+		t.pos++
+		y, err := t.and()
+		if err != nil {
+			return false, err
+		}
+		x = x || y
+	}
+	return x, nil
+}
+
+func (t *tester) and() (bool, error) {
+	x, err := t.not()
+	if err != nil {
+		return false, err
+	}
+	for t.peek() == "-a" {
+		t.pos++
+		y, err := t.not()
+		if err != nil {
+			return false, err
+		}
+		x = x && y
+	}
+	return x, nil
+}
+
+func (t *tester) not() (bool, error) {
+	if t.peek() == "!" && t.pos+1 < len(t.args) {
+		t.pos++
+		x, err := t.not()
+		return !x, err
+	}
+	return t.primary()
+}
+
+func (t *tester) primary() (bool, error) {
+	if t.pos >= len(t.args) {
+		return false, fmt.Errorf("argument expected")
+	}
+	a := t.args[t.pos]
+
+	if a == "(" {
+		t.pos++
+		x, err := t.expr()
+		if err != nil {
+			return false, err
+		}
+		if t.peek() != ")" {
+			return false, fmt.Errorf("missing ')'")
+		}
+		t.pos++
+		return x, nil
+	}
+
+	// Binary operators take precedence: [ "$a" = -n ] compares strings.
+	if t.pos+2 < len(t.args) {
+		if ok, handled, err := t.binary(a, t.args[t.pos+1]); handled {
+			return ok, err
+		}
+	}
+
+	if len(a) == 2 && a[0] == '-' && t.pos+1 < len(t.args) {
+		if ok, handled := t.unary(a[1], t.args[t.pos+1]); handled {
+			t.pos += 2
+			return ok, nil
+		}
+	}
+
+	t.pos++
+	return a != "", nil
+}
+
+// binary evaluates "x OP y" if args[pos+1] is a binary operator.
+func (t *tester) binary(x, op string) (ok, handled bool, err error) {
+	y := t.args[t.pos+2]
+	switch op {
+	case "=", "==":
+		ok = x == y
+	case "!=":
+		ok = x != y
+	case "<":
+		ok = x < y
+	case ">":
+		ok = x > y
+	case "-eq", "-ne", "-lt", "-le", "-gt", "-ge":
+		a, err1 := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+		b, err2 := strconv.ParseInt(strings.TrimSpace(y), 10, 64)
+		if err1 != nil {
+			return false, true, fmt.Errorf("%s: integer expression expected", x)
+		}
+		if err2 != nil {
+			return false, true, fmt.Errorf("%s: integer expression expected", y)
+		}
+		switch op {
+		case "-eq":
+			ok = a == b
+		case "-ne":
+			ok = a != b
+		case "-lt":
+			ok = a < b
+		case "-le":
+			ok = a <= b
+		case "-gt":
+			ok = a > b
+		case "-ge":
+			ok = a >= b
+		}
+	case "-nt", "-ot", "-ef":
+		fa, errA := t.sh.ctx.Files().Stat(t.sh.path(x))
+		fb, errB := t.sh.ctx.Files().Stat(t.sh.path(y))
+		switch op {
+		case "-nt":
+			ok = errA == nil && (errB != nil || fa.ModTime().After(fb.ModTime()))
+		case "-ot":
+			ok = errB == nil && (errA != nil || fa.ModTime().Before(fb.ModTime()))
+		case "-ef":
+			ok = errA == nil && errB == nil && os.SameFile(fa, fb)
+		}
+	default:
+		return false, false, nil
+	}
+	t.pos += 3
+	return ok, true, nil
+}
+
+func (t *tester) unary(op byte, arg string) (ok, handled bool) {
+	switch op {
+	case 'z':
+		return arg == "", true
+	case 'n':
+		return arg != "", true
+	case 'v':
+		_, set := t.sh.lookup(arg)
+		return set, true
+	case 't':
+		return false, true
+	}
+
+	path := t.sh.path(arg)
+	var fi os.FileInfo
+	var err error
+	if op == 'L' || op == 'h' {
+		fi, err = t.sh.ctx.Files().Lstat(path)
+	} else {
+		fi, err = t.sh.ctx.Files().Stat(path)
+	}
+	switch op {
+	case 'e', 'a':
+		return err == nil, true
+	case 'f':
+		return err == nil && fi.Mode().IsRegular(), true
+	case 'd':
+		return err == nil && fi.IsDir(), true
+	case 's':
+		return err == nil && fi.Size() > 0, true
+	case 'L', 'h':
+		return err == nil && fi.Mode()&os.ModeSymlink != 0, true
+	case 'p':
+		return err == nil && fi.Mode()&os.ModeNamedPipe != 0, true
+	case 'r':
+		if err != nil {
+			return false, true
+		}
+		f, oerr := t.sh.ctx.Files().Open(path)
+		if oerr == nil {
+			f.Close()
+		}
+		return oerr == nil, true
+	case 'w':
+		return err == nil && fi.Mode().Perm()&0222 != 0, true
+	case 'x':
+		return err == nil && (fi.IsDir() || fi.Mode().Perm()&0111 != 0 || isExecutableName(path)), true
+	}
+	return false, false
+}
+
+// isExecutableName reports whether Windows would run path directly.
+func isExecutableName(path string) bool {
+	lower := strings.ToLower(path)
+	for _, ext := range []string{".exe", ".com", ".bat", ".cmd", ".ps1", ".sh"} {
+		if strings.HasSuffix(lower, ext) {
+			return true
+		}
+	}
+	return false
+}
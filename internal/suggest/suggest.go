@@ -0,0 +1,100 @@
+// Package suggest finds the known names closest to a mistyped one, for
+// "did you mean" hints.
+package suggest
+
+import (
+	"sort"
+	"strings"
+)
+
+// Distance returns the edit distance between a and b, ignoring case:
+// the number of single-character insertions, deletions, substitutions
+// and transpositions of adjacent characters that turn one into the
+// other.
+func Distance(a, b string) int {
+	s, t := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+
+	// Three rows of the matrix are enough for transpositions.
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d = min(d, prev2[j-2]+1)
+			}
+			cur[j] = d
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(t)]
+}
+
+// Threshold is the largest distance at which a candidate still counts
+// as similar to a name of length n: one edit for short names, growing
+// to a third of the length.
+func Threshold(n int) int {
+	if n < 6 {
+		return 1 + n/4
+	}
+	return n / 3
+}
+
+// Similar returns the candidates within Threshold of name, closest
+// first and then in alphabetical order, without duplicates. A
+// candidate that starts with name also counts, so "gr" suggests "grep".
+func Similar(name string, candidates []string) []string {
+	type match struct {
+		s string
+		d int
+	}
+	limit := Threshold(len([]rune(name)))
+	seen := make(map[string]bool)
+	var matches []match
+	for _, c := range candidates {
+		if seen[c] || strings.EqualFold(c, name) {
+			continue
+		}
+		seen[c] = true
+		d := Distance(name, c)
+		if d > limit && (len(name) < 2 || !strings.HasPrefix(strings.ToLower(c), strings.ToLower(name))) {
+			continue
+		}
+		matches = append(matches, match{c, d})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].d != matches[j].d {
+			return matches[i].d < matches[j].d
+		}
+		return matches[i].s < matches[j].s
+	})
+
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.s
+	}
+	return out
+}
+
+// Best returns the single closest candidate, if exactly one candidate
+// is closest and within Threshold.
+func Best(name string, candidates []string) (string, bool) {
+	list := Similar(name, candidates)
+	if len(list) == 0 {
+		return "", false
+	}
+	d := Distance(name, list[0])
+	if d > Threshold(len([]rune(name))) || len(list) > 1 && Distance(name, list[1]) == d {
+		return "", false
+	}
+	return list[0], true
+}
@@ -0,0 +1,12 @@
+//go:build !windows && !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+
+package term
+
+import (
+	"fmt"
+	"os"
+)
+
+func size(f *os.File) (int, int, error) {
+	return 0, 0, fmt.Errorf("terminal size is not available on this system")
+}
@@ -0,0 +1,127 @@
+package term
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Style is a list of SGR parameters separated by ";", such as "01;34"
+// for bold blue, as used in LS_COLORS and GREP_COLORS.
+type Style string
+
+// Common styles.
+const (
+	Reset     Style = "0"
+	Bold      Style = "1"
+	Underline Style = "4"
+	Reverse   Style = "7"
+	Red       Style = "31"
+	Green     Style = "32"
+	Yellow    Style = "33"
+	Blue      Style = "34"
+	Magenta   Style = "35"
+	Cyan      Style = "36"
+)
+
+// Combine returns a style applying all of styles.
+func Combine(styles ...Style) Style {
+	parts := make([]string, 0, len(styles))
+	for _, s := range styles {
+		if s != "" {
+			parts = append(parts, string(s))
+		}
+	}
+	return Style(strings.Join(parts, ";"))
+}
+
+// Sequence returns the escape sequence that turns on s.
+func (s Style) Sequence() string {
+	return "\x1b[" + string(s) + "m"
+}
+
+// Escape sequences for full-screen programs.
+const (
+	ClearLine       = "\x1b[K"      // clear to the end of the line
+	CursorHome      = "\x1b[H"      // move the cursor to the top left
+	AltScreenEnter  = "\x1b[?1049h" // switch to the alternate screen
+	AltScreenLeave  = "\x1b[?1049l" // and back to the main screen
+	resetAttributes = "\x1b[0m"
+)
+
+// MoveTo returns the escape sequence moving the cursor to row and
+// column, both counted from 1.
+func MoveTo(row, col int) string {
+	return fmt.Sprintf("\x1b[%d;%dH", row, col)
+}
+
+// Writer writes to an underlying writer, wrapping styled text in escape
+// sequences when colour is on and writing it plainly otherwise.
+type Writer struct {
+	w     io.Writer
+	color bool
+}
+
+// NewWriter returns a Writer to w that colours text if color is true;
+// see UseColor.
+func NewWriter(w io.Writer, color bool) *Writer {
+	return &Writer{w: w, color: color}
+}
+
+// Color reports whether w colours text.
+func (w *Writer) Color() bool {
+	return w.color
+}
+
+// Write writes p unstyled.
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+// Print writes s in style.
+func (w *Writer) Print(style Style, s string) error {
+	if !w.color || style == "" || s == "" {
+		_, err := io.WriteString(w.w, s)
+		return err
+	}
+	_, err := io.WriteString(w.w, style.Sequence()+s+resetAttributes)
+	return err
+}
+
+// Printf formats according to format and writes the result in style.
+func (w *Writer) Printf(style Style, format string, args ...any) error {
+	return w.Print(style, fmt.Sprintf(format, args...))
+}
+
+// Sprint returns s in style when color is true, and s otherwise, for
+// building lines before writing them.
+func Sprint(color bool, style Style, s string) string {
+	if !color || style == "" || s == "" {
+		return s
+	}
+	return style.Sequence() + s + resetAttributes
+}
+
+// Strip removes SGR escape sequences from s, leaving the text whose
+// width matters for alignment.
+func Strip(s string) string {
+	if !strings.Contains(s, "\x1b[") {
+		return s
+	}
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "\x1b[")
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[:i])
+		j := strings.IndexByte(s[i:], 'm')
+		if j < 0 {
+			s = s[i:]
+			break
+		}
+		s = s[i+j+1:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,119 @@
+// Package term describes the terminal commands write to: whether output
+// is a terminal, its size, and whether and how to colour text.
+//
+// Colour follows, in order: an explicit --color=always or never, the
+// ui.color setting, NO_COLOR and FORCE_COLOR, and finally whether the
+// output is a terminal that understands ANSI escape sequences, which
+// on Windows means a console with virtual terminal processing enabled.
+package term
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// When says when to colour output, as in --color=WHEN.
+type When int
+
+const (
+	Auto   When = iota // colour terminals only
+	Always             // colour even when writing to a file or pipe
+	Never
+)
+
+var whenNames = map[string]When{
+	"auto": Auto, "tty": Auto, "if-tty": Auto,
+	"always": Always, "yes": Always, "force": Always,
+	"never": Never, "no": Never, "none": Never,
+}
+
+// ParseWhen parses a --color argument. It accepts the GNU spellings:
+// auto, tty and if-tty; always, yes and force; never, no and none.
+func ParseWhen(s string) (When, error) {
+	if w, ok := whenNames[strings.ToLower(s)]; ok {
+		return w, nil
+	}
+	return Auto, fmt.Errorf("invalid argument '%s'", s)
+}
+
+func (w When) String() string {
+	switch w {
+	case Always:
+		return "always"
+	case Never:
+		return "never"
+	}
+	return "auto"
+}
+
+// IsTerminal reports whether w is a terminal or console.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && isTerminal(f)
+}
+
+// EnableVT makes the terminal w interpret ANSI escape sequences, which
+// Windows consoles only do on request. It returns a function restoring
+// the previous state. Writers that are not consoles are left alone.
+func EnableVT(w io.Writer) (restore func(), err error) {
+	f, ok := w.(*os.File)
+	if !ok || !isTerminal(f) {
+		return func() {}, nil
+	}
+	return enableVT(f)
+}
+
+// Size returns the width and height in characters of the terminal w.
+func Size(w io.Writer) (width, height int, err error) {
+	f, ok := w.(*os.File)
+	if !ok || !isTerminal(f) {
+		return 0, 0, fmt.Errorf("not a terminal")
+	}
+	return size(f)
+}
+
+// Width returns the number of columns to format output to: COLUMNS if
+// set, else the width of the terminal w, else 80.
+func Width(w io.Writer, getenv func(string) string) int {
+	if n, err := strconv.Atoi(getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	if n, _, err := Size(w); err == nil && n > 0 {
+		return n
+	}
+	return 80
+}
+
+// UseColor reports whether to colour output to w. when is the
+// command's --color setting and config the ui.color setting, either
+// possibly empty; getenv looks up NO_COLOR, FORCE_COLOR and TERM.
+// Colouring a console enables VT mode on it, whatever the reason for
+// colouring; the caller runs restore when done writing to put the
+// console back as it was. restore is never nil.
+func UseColor(w io.Writer, when When, config string, getenv func(string) string) (color bool, restore func()) {
+	if when == Auto && config != "" {
+		when, _ = ParseWhen(config)
+	}
+	var forced bool
+	switch {
+	case when == Always:
+		forced = true
+	case when == Never:
+		return false, func() {}
+	case getenv("NO_COLOR") != "":
+		return false, func() {}
+	case getenv("FORCE_COLOR") != "" && getenv("FORCE_COLOR") != "0":
+		forced = true
+	case !IsTerminal(w) || getenv("TERM") == "dumb":
+		return false, func() {}
+	}
+	// Forced colour is written even where the console cannot show it.
+	restore, err := EnableVT(w)
+	if err != nil {
+		return forced, func() {}
+	}
+	return true, restore
+}
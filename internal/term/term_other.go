@@ -0,0 +1,16 @@
+//go:build !windows
+
+package term
+
+import "os"
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Terminals other than Windows consoles interpret escape sequences
+// already.
+func enableVT(f *os.File) (func(), error) {
+	return func() {}, nil
+}
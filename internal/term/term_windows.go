@@ -0,0 +1,74 @@
+package term
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32                       = syscall.NewLazyDLL("kernel32.dll")
+	procGetConsoleMode             = kernel32.NewProc("GetConsoleMode")
+	procSetConsoleMode             = kernel32.NewProc("SetConsoleMode")
+	procGetConsoleScreenBufferInfo = kernel32.NewProc("GetConsoleScreenBufferInfo")
+)
+
+// enableVirtualTerminalProcessing makes a console output handle
+// interpret ANSI escape sequences.
+const enableVirtualTerminalProcessing = 0x0004
+
+type consoleScreenBufferInfo struct {
+	Size              coord
+	CursorPosition    coord
+	Attributes        uint16
+	Window            smallRect
+	MaximumWindowSize coord
+}
+
+type coord struct {
+	X, Y int16
+}
+
+type smallRect struct {
+	Left, Top, Right, Bottom int16
+}
+
+func consoleMode(f *os.File) (uint32, error) {
+	var mode uint32
+	r, _, err := procGetConsoleMode.Call(f.Fd(), uintptr(unsafe.Pointer(&mode)))
+	if r == 0 {
+		return 0, err
+	}
+	return mode, nil
+}
+
+func isTerminal(f *os.File) bool {
+	_, err := consoleMode(f)
+	return err == nil
+}
+
+func enableVT(f *os.File) (func(), error) {
+	mode, err := consoleMode(f)
+	if err != nil {
+		return nil, err
+	}
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return func() {}, nil
+	}
+	if r, _, err := procSetConsoleMode.Call(f.Fd(), uintptr(mode|enableVirtualTerminalProcessing)); r == 0 {
+		// Consoles before Windows 10 do not support it.
+		return nil, err
+	}
+	return func() {
+		procSetConsoleMode.Call(f.Fd(), uintptr(mode))
+	}, nil
+}
+
+func size(f *os.File) (int, int, error) {
+	var info consoleScreenBufferInfo
+	r, _, err := procGetConsoleScreenBufferInfo.Call(f.Fd(), uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0, 0, err
+	}
+	return int(info.Window.Right-info.Window.Left) + 1, int(info.Window.Bottom-info.Window.Top) + 1, nil
+}
@@ -0,0 +1,218 @@
+// Package textio reads text line by line, however long the lines are,
+// keeping their endings so that output can reproduce them. It can
+// detect the encoding of text from its byte order mark and decode
+// UTF-16, which Windows tools such as PowerShell 5 write, to UTF-8.
+package textio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Encoding is the encoding of text, as told by its byte order mark.
+type Encoding int
+
+const (
+	UTF8    Encoding = iota // no byte order mark; assumed to be UTF-8
+	UTF8BOM                 // UTF-8 with a byte order mark
+	UTF16LE
+	UTF16BE
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case UTF8BOM:
+		return "UTF-8 with BOM"
+	case UTF16LE:
+		return "UTF-16LE"
+	case UTF16BE:
+		return "UTF-16BE"
+	}
+	return "UTF-8"
+}
+
+// DefaultSize is the size of the buffer of a Reader from NewReader.
+const DefaultSize = 64 * 1024
+
+// Reader reads lines from an underlying reader.
+type Reader struct {
+	raw  *bufio.Reader // the underlying reader
+	src  *bufio.Reader // text read from: raw, or decoded from it
+	enc  Encoding
+	line []byte // the last line read, if it did not fit in src
+}
+
+// NewReader returns a Reader with a buffer of DefaultSize.
+func NewReader(r io.Reader) *Reader {
+	return NewReaderSize(r, DefaultSize)
+}
+
+// NewReaderSize returns a Reader whose buffer has at least size bytes.
+// Lines may be longer than the buffer.
+func NewReaderSize(r io.Reader, size int) *Reader {
+	raw := bufio.NewReaderSize(r, size)
+	return &Reader{raw: raw, src: raw}
+}
+
+// Reset discards the state of r and makes it read from rd, so that its
+// buffer can be reused.
+func (r *Reader) Reset(rd io.Reader) {
+	r.raw.Reset(rd)
+	r.src, r.enc, r.line = r.raw, UTF8, r.line[:0]
+}
+
+// DetectEncoding looks for a byte order mark at the start of the
+// input and returns the encoding it tells. The mark is skipped, and
+// UTF-16 is decoded so that the reads that follow return UTF-8, with
+// invalid code units replaced by U+FFFD. It must be called before
+// reading.
+func (r *Reader) DetectEncoding() Encoding {
+	// Wait for more than the first byte only if it can start a mark,
+	// so that short lines from a pipe are not held up.
+	head, _ := r.raw.Peek(1)
+	switch {
+	case len(head) == 0:
+		return r.enc
+	case head[0] == 0xEF:
+		head, _ = r.raw.Peek(3)
+	case head[0] == 0xFF || head[0] == 0xFE:
+		head, _ = r.raw.Peek(2)
+	}
+	switch {
+	case len(head) >= 3 && head[0] == 0xEF && head[1] == 0xBB && head[2] == 0xBF:
+		r.raw.Discard(3)
+		r.enc = UTF8BOM
+	case len(head) >= 2 && head[0] == 0xFF && head[1] == 0xFE:
+		r.raw.Discard(2)
+		r.enc = UTF16LE
+		r.src = bufio.NewReaderSize(&utf16Reader{r: r.raw, order: binary.LittleEndian}, r.raw.Size())
+	case len(head) >= 2 && head[0] == 0xFE && head[1] == 0xFF:
+		r.raw.Discard(2)
+		r.enc = UTF16BE
+		r.src = bufio.NewReaderSize(&utf16Reader{r: r.raw, order: binary.BigEndian}, r.raw.Size())
+	}
+	return r.enc
+}
+
+// Encoding returns the encoding found by DetectEncoding.
+func (r *Reader) Encoding() Encoding {
+	return r.enc
+}
+
+// Peek returns the next n bytes of text without reading them, or fewer
+// with an error if the input ends first.
+func (r *Reader) Peek(n int) ([]byte, error) {
+	return r.src.Peek(n)
+}
+
+// Buffered returns the text that can be read without waiting on the
+// underlying reader, reading from it once if none is buffered. It
+// returns nothing at the end of input. The bytes are only valid until
+// the next read.
+func (r *Reader) Buffered() []byte {
+	r.src.Peek(1)
+	b, _ := r.src.Peek(r.src.Buffered())
+	return b
+}
+
+// ReadLine returns the next line without its ending, and the ending:
+// "\n", "\r\n", or "" for a last line with no newline. The line is only
+// valid until the next call. At the end of input, ReadLine returns
+// io.EOF.
+func (r *Reader) ReadLine() (line []byte, end string, err error) {
+	line, err = r.src.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		// Too long for the buffer: collect the pieces
+		r.line = append(r.line[:0], line...)
+		for errors.Is(err, bufio.ErrBufferFull) {
+			line, err = r.src.ReadSlice('\n')
+			r.line = append(r.line, line...)
+		}
+		line = r.line
+	}
+	switch {
+	case err == io.EOF && len(line) > 0:
+		err = nil
+	case err != nil:
+		return nil, "", err
+	}
+
+	n := len(line)
+	switch {
+	case n >= 2 && line[n-2] == '\r' && line[n-1] == '\n':
+		return line[:n-2], "\r\n", nil
+	case n >= 1 && line[n-1] == '\n':
+		return line[:n-1], "\n", nil
+	}
+	return line, "", nil
+}
+
+// utf16Reader decodes UTF-16 to UTF-8.
+type utf16Reader struct {
+	r       *bufio.Reader
+	order   binary.ByteOrder
+	pending []byte // decoded but not yet read
+}
+
+func (d *utf16Reader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(d.pending) > 0 {
+			c := copy(p[n:], d.pending)
+			d.pending = d.pending[c:]
+			n += c
+			continue
+		}
+		// Return what there is rather than wait for more
+		if n > 0 && d.r.Buffered() < 2 {
+			break
+		}
+		r, err := d.rune()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		d.pending = utf8.AppendRune(d.pending[:0], r)
+	}
+	return n, nil
+}
+
+// rune decodes the next character.
+func (d *utf16Reader) rune() (rune, error) {
+	u, err := d.unit()
+	if err != nil {
+		return 0, err
+	}
+	r := rune(u)
+	if !utf16.IsSurrogate(r) {
+		return r, nil
+	}
+	// A high surrogate must be followed by a low one
+	if b, _ := d.r.Peek(2); len(b) == 2 && r < 0xDC00 {
+		if dec := utf16.DecodeRune(r, rune(d.order.Uint16(b))); dec != utf8.RuneError {
+			d.r.Discard(2)
+			return dec, nil
+		}
+	}
+	return utf8.RuneError, nil
+}
+
+// unit reads a code unit. A last odd byte decodes as U+FFFD.
+func (d *utf16Reader) unit() (uint16, error) {
+	b, err := d.r.Peek(2)
+	switch len(b) {
+	case 2:
+		d.r.Discard(2)
+		return d.order.Uint16(b), nil
+	case 1:
+		d.r.Discard(1)
+		return utf8.RuneError, nil
+	}
+	return 0, err
+}
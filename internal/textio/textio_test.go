@@ -0,0 +1,173 @@
+package textio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf16"
+)
+
+// readAll returns the lines of r, each followed by its ending.
+func readAll(t *testing.T, r *Reader) []string {
+	t.Helper()
+	var lines []string
+	for {
+		line, end, err := r.ReadLine()
+		if err == io.EOF {
+			return lines
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(line)+end)
+	}
+}
+
+// encodeUTF16 returns s in UTF-16 in the given byte order, after a byte
+// order mark.
+func encodeUTF16(s string, order binary.AppendByteOrder) []byte {
+	b := order.AppendUint16(nil, 0xFEFF)
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = order.AppendUint16(b, u)
+	}
+	return b
+}
+
+func TestReadLine(t *testing.T) {
+	long := strings.Repeat("0123456789", 10)
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"lines", "a\nb\n", []string{"a\n", "b\n"}},
+		{"no final newline", "a\nb", []string{"a\n", "b"}},
+		{"crlf", "a\r\nb\r\n\r\n", []string{"a\r\n", "b\r\n", "\r\n"}},
+		{"mixed endings", "a\r\nb\nc\r", []string{"a\r\n", "b\n", "c\r"}},
+		{"lone cr", "a\rb\n", []string{"a\rb\n"}},
+		{"empty lines", "\n\n", []string{"\n", "\n"}},
+		{"longer than the buffer", long + "\n" + long + "\r\nx", []string{long + "\n", long + "\r\n", "x"}},
+		{"crlf across the buffer", strings.Repeat("x", 15) + "\r\ny", []string{strings.Repeat("x", 15) + "\r\n", "y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The smallest buffer bufio allows, 16 bytes, and reads of
+			// one byte, so that lines are split across reads
+			r := NewReaderSize(iotest.OneByteReader(strings.NewReader(tt.in)), 16)
+			if got := readAll(t, r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadLineDefaultSize(t *testing.T) {
+	long := strings.Repeat("x", DefaultSize*3+7)
+	r := NewReader(strings.NewReader(long + "\r\n" + "short\n" + long))
+	want := []string{long + "\r\n", "short\n", long}
+	if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+		t.Errorf("read %d lines of %v bytes, want lengths %d, 6, %d", len(got), lengths(got), len(long)+2, len(long))
+	}
+}
+
+func lengths(lines []string) []int {
+	var n []int
+	for _, l := range lines {
+		n = append(n, len(l))
+	}
+	return n
+}
+
+func TestDetectEncoding(t *testing.T) {
+	const text = "héllo 😀\r\nwörld\n"
+	tests := []struct {
+		name string
+		in   []byte
+		enc  Encoding
+		want []string
+	}{
+		{"utf-8", []byte(text), UTF8, []string{"héllo 😀\r\n", "wörld\n"}},
+		{"utf-8 bom", append([]byte("\xEF\xBB\xBF"), text...), UTF8BOM, []string{"héllo 😀\r\n", "wörld\n"}},
+		{"utf-16le", encodeUTF16(text, binary.LittleEndian), UTF16LE, []string{"héllo 😀\r\n", "wörld\n"}},
+		{"utf-16be", encodeUTF16(text, binary.BigEndian), UTF16BE, []string{"héllo 😀\r\n", "wörld\n"}},
+		{"utf-16le odd byte", append(encodeUTF16("ab", binary.LittleEndian), 'c'), UTF16LE, []string{"ab�"}},
+		{"utf-16be odd byte", append(encodeUTF16("a\n", binary.BigEndian), 0), UTF16BE, []string{"a\n", "�"}},
+		{"utf-16le lone surrogate", []byte("\xFF\xFE\x00\xD8a\x00"), UTF16LE, []string{"�a"}},
+		{"utf-16le bom only", []byte("\xFF\xFE"), UTF16LE, nil},
+		{"partial utf-8 bom", []byte("\xEF\xBB"), UTF8, []string{"\xEF\xBB"}},
+		{"one byte", []byte("\xFF"), UTF8, []string{"\xFF"}},
+		{"empty", nil, UTF8, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReaderSize(iotest.OneByteReader(bytes.NewReader(tt.in)), 16)
+			if enc := r.DetectEncoding(); enc != tt.enc || r.Encoding() != tt.enc {
+				t.Errorf("DetectEncoding() = %v, want %v", enc, tt.enc)
+			}
+			if got := readAll(t, r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTF16LongLines(t *testing.T) {
+	long := strings.Repeat("ü", 50)
+	in := encodeUTF16(long+"\r\n"+long, binary.LittleEndian)
+	r := NewReaderSize(bytes.NewReader(in), 16)
+	r.DetectEncoding()
+	want := []string{long + "\r\n", long}
+	if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+		t.Errorf("lines = %q, want %q", got, want)
+	}
+}
+
+// trickle returns its data in a single read, and fails the test if it
+// is read again, as a pipe whose writer has not written more would
+// block.
+type trickle struct {
+	t    *testing.T
+	data []byte
+}
+
+func (r *trickle) Read(p []byte) (int, error) {
+	if r.data == nil {
+		r.t.Fatal("read past the available input")
+	}
+	n := copy(p, r.data)
+	r.data = nil
+	return n, nil
+}
+
+func TestNoWaitOnPipes(t *testing.T) {
+	r := NewReader(&trickle{t: t, data: []byte("a\x00b\n")})
+	if enc := r.DetectEncoding(); enc != UTF8 {
+		t.Errorf("DetectEncoding() = %v, want UTF-8", enc)
+	}
+	if got := string(r.Buffered()); got != "a\x00b\n" {
+		t.Errorf("Buffered() = %q", got)
+	}
+	if line, end, err := r.ReadLine(); string(line) != "a\x00b" || end != "\n" || err != nil {
+		t.Errorf("ReadLine() = %q, %q, %v", line, end, err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	r := NewReaderSize(bytes.NewReader(encodeUTF16("a\n", binary.BigEndian)), 16)
+	r.DetectEncoding()
+	readAll(t, r)
+
+	r.Reset(strings.NewReader(strings.Repeat("y", 40) + "\nz"))
+	if r.Encoding() != UTF8 {
+		t.Errorf("Encoding() after Reset = %v", r.Encoding())
+	}
+	want := []string{strings.Repeat("y", 40) + "\n", "z"}
+	if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+		t.Errorf("lines after Reset = %q, want %q", got, want)
+	}
+}
@@ -81,7 +81,7 @@ func CheckForUpdates() *UpdateInfo {
 	info.ReleaseNotes = release.Body
 	
 	// Parse first 5 meaningful lines from changelog
-	info.Summary = parseChangelogSummary(release.Body, 5)
+	info.Summary = ParseChangelogSummary(release.Body, 5)
 	
 	// Find download URL
 	for _, asset := range release.Assets {
@@ -99,8 +99,8 @@ func CheckForUpdates() *UpdateInfo {
 	return info
 }
 
-// parseChangelogSummary extracts the first N meaningful lines from release notes
-func parseChangelogSummary(body string, maxLines int) []string {
+// ParseChangelogSummary extracts the first N meaningful lines from release notes
+func ParseChangelogSummary(body string, maxLines int) []string {
 	lines := strings.Split(body, "\n")
 	var summary []string
 	
@@ -6,5 +6,6 @@ const (
 	ExitSuccess         = 0   // Command completed successfully
 	ExitFailure         = 1   // General failure (e.g., no matches found)
 	ExitUsageError      = 2   // Invalid usage, missing args, permission denied
+	ExitCannotExecute   = 126 // Command found but could not be started
 	ExitCommandNotFound = 127 // Command not recognized
 )
@@ -0,0 +1,339 @@
+package vfs
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mem is an in-memory FS for tests. Relative names are resolved against
+// the root, "/" and "\" both separate names and volume names are
+// ignored. It has no symbolic links, so Lstat is Stat. The zero value
+// is an empty filesystem ready to use.
+type Mem struct {
+	mu    sync.Mutex
+	nodes map[string]*memNode // by clean slash path
+	now   func() time.Time
+}
+
+var _ FS = (*Mem)(nil)
+
+type memNode struct {
+	name    string
+	mode    fs.FileMode
+	data    []byte
+	modTime time.Time
+}
+
+// NewMem returns an empty Mem whose files are timestamped with now, or
+// with the current time if now is nil.
+func NewMem(now func() time.Time) *Mem {
+	return &Mem{now: now}
+}
+
+// AddFile creates the named file and its parent directories.
+func (m *Mem) AddFile(name, data string, mode fs.FileMode) error {
+	if err := m.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	return WriteFile(m, name, []byte(data), mode)
+}
+
+// key returns the map key of name and whether it names a root.
+func (m *Mem) key(name string) (string, bool) {
+	k := filepath.ToSlash(filepath.Clean(strings.ReplaceAll(name, `\`, "/")))
+	vol := filepath.VolumeName(k)
+	rest := strings.TrimPrefix(k[len(vol):], "/")
+	if rest == "." {
+		rest = ""
+	}
+	return rest, rest == ""
+}
+
+// parent returns the key of the directory holding k.
+func parent(k string) string {
+	if i := strings.LastIndexByte(k, '/'); i >= 0 {
+		return k[:i]
+	}
+	return ""
+}
+
+func (m *Mem) timestamp() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// lookup returns the node for k, with a directory node for the root.
+// The caller holds m.mu.
+func (m *Mem) lookup(k string, root bool) (*memNode, bool) {
+	if root {
+		return &memNode{name: "/", mode: fs.ModeDir | 0755}, true
+	}
+	n, ok := m.nodes[k]
+	return n, ok
+}
+
+// checkParent reports whether the parent of k exists and is a
+// directory. The caller holds m.mu.
+func (m *Mem) checkParent(k string) error {
+	p := parent(k)
+	n, ok := m.lookup(p, p == "")
+	switch {
+	case !ok:
+		return fs.ErrNotExist
+	case !n.mode.IsDir():
+		return ErrNotDir
+	}
+	return nil
+}
+
+func (m *Mem) Open(name string) (File, error) {
+	return m.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (m *Mem) OpenFile(name string, flag int, perm fs.FileMode) (File, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k, root := m.key(name)
+	n, ok := m.lookup(k, root)
+	switch {
+	case ok && flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrExist}
+	case ok && n.mode.IsDir() && flag&(os.O_WRONLY|os.O_RDWR) != 0:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: ErrIsDir}
+	case !ok && flag&os.O_CREATE == 0:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	case !ok:
+		if err := m.checkParent(k); err != nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+		n = &memNode{name: filepath.Base(name), mode: perm.Perm(), modTime: m.timestamp()}
+		if m.nodes == nil {
+			m.nodes = make(map[string]*memNode)
+		}
+		m.nodes[k] = n
+	case flag&os.O_TRUNC != 0 && flag&(os.O_WRONLY|os.O_RDWR) != 0:
+		n.data = nil
+		n.modTime = m.timestamp()
+	}
+	return &memFile{m: m, n: n, name: name, flag: flag}, nil
+}
+
+func (m *Mem) Stat(name string) (fs.FileInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.lookup(m.key(name))
+	if !ok {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+	return n.info(), nil
+}
+
+func (m *Mem) Lstat(name string) (fs.FileInfo, error) {
+	info, err := m.Stat(name)
+	if err != nil {
+		err.(*fs.PathError).Op = "lstat"
+	}
+	return info, err
+}
+
+func (m *Mem) ReadDir(name string) ([]fs.DirEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, root := m.key(name)
+	n, ok := m.lookup(k, root)
+	switch {
+	case !ok:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	case !n.mode.IsDir():
+		return nil, &fs.PathError{Op: "readdirent", Path: name, Err: ErrNotDir}
+	}
+
+	var entries []fs.DirEntry
+	for ck, c := range m.nodes {
+		if parent(ck) == k {
+			entries = append(entries, fs.FileInfoToDirEntry(c.info()))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (m *Mem) Mkdir(name string, perm fs.FileMode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, root := m.key(name)
+	if _, ok := m.lookup(k, root); ok {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrExist}
+	}
+	if err := m.checkParent(k); err != nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+	}
+	if m.nodes == nil {
+		m.nodes = make(map[string]*memNode)
+	}
+	m.nodes[k] = &memNode{name: filepath.Base(name), mode: fs.ModeDir | perm.Perm(), modTime: m.timestamp()}
+	return nil
+}
+
+func (m *Mem) MkdirAll(name string, perm fs.FileMode) error {
+	info, err := m.Stat(name)
+	if err == nil {
+		if !info.IsDir() {
+			return &fs.PathError{Op: "mkdir", Path: name, Err: ErrNotDir}
+		}
+		return nil
+	}
+	if dir := filepath.Dir(name); dir != name {
+		if err := m.MkdirAll(dir, perm); err != nil {
+			return err
+		}
+	}
+	if err := m.Mkdir(name, perm); err != nil && !os.IsExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (m *Mem) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, root := m.key(name)
+	n, ok := m.lookup(k, root)
+	switch {
+	case !ok:
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	case root:
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrPermission}
+	case n.mode.IsDir():
+		for ck := range m.nodes {
+			if parent(ck) == k {
+				return &fs.PathError{Op: "remove", Path: name, Err: ErrNotEmpty}
+			}
+		}
+	}
+	delete(m.nodes, k)
+	return nil
+}
+
+func (m *Mem) RemoveAll(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, root := m.key(name)
+	if root {
+		return &fs.PathError{Op: "unlinkat", Path: name, Err: fs.ErrPermission}
+	}
+	for ck := range m.nodes {
+		if ck == k || strings.HasPrefix(ck, k+"/") {
+			delete(m.nodes, ck)
+		}
+	}
+	return nil
+}
+
+func (m *Mem) Chtimes(name string, atime, mtime time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k, root := m.key(name)
+	n, ok := m.lookup(k, root)
+	if !ok || root {
+		return &fs.PathError{Op: "chtimes", Path: name, Err: fs.ErrNotExist}
+	}
+	n.modTime = mtime
+	return nil
+}
+
+func (n *memNode) info() fs.FileInfo {
+	return memInfo{name: n.name, size: int64(len(n.data)), mode: n.mode, modTime: n.modTime}
+}
+
+// memInfo is the fs.FileInfo of a Mem file.
+type memInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (i memInfo) Name() string       { return i.name }
+func (i memInfo) Size() int64        { return i.size }
+func (i memInfo) Mode() fs.FileMode  { return i.mode }
+func (i memInfo) ModTime() time.Time { return i.modTime }
+func (i memInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i memInfo) Sys() any           { return nil }
+
+// memFile is an open Mem file. Writes go straight to the node, so they
+// are visible to other handles at once.
+type memFile struct {
+	m      *Mem
+	n      *memNode
+	name   string
+	flag   int
+	off    int
+	closed bool
+}
+
+func (f *memFile) Read(p []byte) (int, error) {
+	f.m.mu.Lock()
+	defer f.m.mu.Unlock()
+	switch {
+	case f.closed:
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrClosed}
+	case f.n.mode.IsDir():
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: ErrIsDir}
+	case f.flag&os.O_WRONLY != 0:
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrPermission}
+	case f.off >= len(f.n.data):
+		return 0, io.EOF
+	}
+	n := copy(p, f.n.data[f.off:])
+	f.off += n
+	return n, nil
+}
+
+func (f *memFile) Write(p []byte) (int, error) {
+	f.m.mu.Lock()
+	defer f.m.mu.Unlock()
+	switch {
+	case f.closed:
+		return 0, &fs.PathError{Op: "write", Path: f.name, Err: fs.ErrClosed}
+	case f.flag&(os.O_WRONLY|os.O_RDWR) == 0:
+		return 0, &fs.PathError{Op: "write", Path: f.name, Err: fs.ErrPermission}
+	}
+	if f.flag&os.O_APPEND != 0 {
+		f.off = len(f.n.data)
+	}
+	if end := f.off + len(p); end > len(f.n.data) {
+		f.n.data = append(f.n.data, make([]byte, end-len(f.n.data))...)
+	}
+	copy(f.n.data[f.off:], p)
+	f.off += len(p)
+	f.n.modTime = f.m.timestamp()
+	return len(p), nil
+}
+
+func (f *memFile) Close() error {
+	f.m.mu.Lock()
+	defer f.m.mu.Unlock()
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.name, Err: fs.ErrClosed}
+	}
+	f.closed = true
+	return nil
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	f.m.mu.Lock()
+	defer f.m.mu.Unlock()
+	return f.n.info(), nil
+}
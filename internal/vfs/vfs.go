@@ -0,0 +1,190 @@
+// Package vfs defines the filesystem winux commands work on.
+//
+// Commands reach files only through an FS taken from their context, so
+// they can run against the real disk (OS), an in-memory tree (Mem) for
+// hermetic tests, or other sources such as archive contents. Names are
+// operating system paths, as produced by core.Context.Path.
+package vfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// File is an open file.
+type File interface {
+	io.Reader
+	io.Writer
+	io.Closer
+	Stat() (fs.FileInfo, error)
+}
+
+// FS is a filesystem.
+type FS interface {
+	// Open opens the named file for reading.
+	Open(name string) (File, error)
+
+	// OpenFile opens the named file with the os.O_* flags in flag,
+	// creating it with perm if os.O_CREATE is given.
+	OpenFile(name string, flag int, perm fs.FileMode) (File, error)
+
+	// Stat returns information about the named file, following
+	// symbolic links; Lstat describes a link itself.
+	Stat(name string) (fs.FileInfo, error)
+	Lstat(name string) (fs.FileInfo, error)
+
+	// ReadDir returns the entries of the named directory, sorted by
+	// name.
+	ReadDir(name string) ([]fs.DirEntry, error)
+
+	Mkdir(name string, perm fs.FileMode) error
+	MkdirAll(name string, perm fs.FileMode) error
+
+	// Remove removes a file or empty directory; RemoveAll removes a
+	// tree and succeeds if name does not exist.
+	Remove(name string) error
+	RemoveAll(name string) error
+
+	Chtimes(name string, atime, mtime time.Time) error
+}
+
+// ReadlinkFS is implemented by filesystems with symbolic links.
+type ReadlinkFS interface {
+	FS
+
+	// Readlink returns the destination of the named symbolic link.
+	Readlink(name string) (string, error)
+}
+
+// OS is the FS of the operating system.
+type OS struct{}
+
+var _ ReadlinkFS = OS{}
+
+func (OS) Open(name string) (File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (OS) OpenFile(name string, flag int, perm fs.FileMode) (File, error) {
+	f, err := os.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (OS) Stat(name string) (fs.FileInfo, error)      { return os.Stat(name) }
+func (OS) Lstat(name string) (fs.FileInfo, error)     { return os.Lstat(name) }
+func (OS) ReadDir(name string) ([]fs.DirEntry, error) { return os.ReadDir(name) }
+func (OS) Mkdir(name string, perm fs.FileMode) error  { return os.Mkdir(name, perm) }
+func (OS) MkdirAll(name string, perm fs.FileMode) error {
+	return os.MkdirAll(name, perm)
+}
+func (OS) Remove(name string) error    { return os.Remove(name) }
+func (OS) RemoveAll(name string) error { return os.RemoveAll(name) }
+func (OS) Chtimes(name string, atime, mtime time.Time) error {
+	return os.Chtimes(name, atime, mtime)
+}
+func (OS) Readlink(name string) (string, error) { return os.Readlink(name) }
+
+// Readlink returns the destination of the named symbolic link, failing
+// with errors.ErrUnsupported if fsys has no symbolic links.
+func Readlink(fsys FS, name string) (string, error) {
+	if rl, ok := fsys.(ReadlinkFS); ok {
+		return rl.Readlink(name)
+	}
+	return "", &fs.PathError{Op: "readlink", Path: name, Err: errors.ErrUnsupported}
+}
+
+// Create creates or truncates the named file, like os.Create.
+func Create(fsys FS, name string) (File, error) {
+	return fsys.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// ReadFile returns the contents of the named file.
+func ReadFile(fsys FS, name string) ([]byte, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// WriteFile writes data to the named file, creating it with perm if
+// needed and truncating it otherwise.
+func WriteFile(fsys FS, name string, data []byte, perm fs.FileMode) error {
+	f, err := fsys.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Walk walks the tree at root like filepath.WalkDir, calling fn for
+// each file and directory in lexical order. Symbolic links are not
+// followed.
+func Walk(fsys FS, root string, fn fs.WalkDirFunc) error {
+	info, err := fsys.Lstat(root)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		err = walk(fsys, root, fs.FileInfoToDirEntry(info), fn)
+	}
+	if err == filepath.SkipDir || err == filepath.SkipAll {
+		return nil
+	}
+	return err
+}
+
+func walk(fsys FS, path string, d fs.DirEntry, fn fs.WalkDirFunc) error {
+	if err := fn(path, d, nil); err != nil || !d.IsDir() {
+		if err == filepath.SkipDir && d.IsDir() {
+			err = nil
+		}
+		return err
+	}
+
+	entries, err := fsys.ReadDir(path)
+	if err != nil {
+		// Report the error a second time, as filepath.WalkDir does.
+		if err = fn(path, d, err); err != nil {
+			if err == filepath.SkipDir && d.IsDir() {
+				err = nil
+			}
+			return err
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	for _, e := range entries {
+		if err := walk(fsys, filepath.Join(path, e.Name()), e, fn); err != nil {
+			if err == filepath.SkipDir {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Errors reported by Mem, wrapped in *fs.PathError.
+var (
+	ErrNotDir   = errors.New("not a directory")
+	ErrIsDir    = errors.New("is a directory")
+	ErrNotEmpty = errors.New("directory not empty")
+)